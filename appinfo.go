@@ -0,0 +1,152 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"codnect.io/procyon/runtime"
+)
+
+const (
+	// AppInfoFileProp is the property key for specifying the file the application info is written to.
+	AppInfoFileProp = "procyon.infofile"
+)
+
+// ApplicationInfo is the application info written as JSON to the file given by the procyon.infofile
+// property.
+type ApplicationInfo struct {
+	Name           string         `json:"name,omitempty"`
+	Version        string         `json:"version,omitempty"`
+	ProcyonVersion string         `json:"procyonVersion"`
+	Pid            int            `json:"pid"`
+	StartTime      time.Time      `json:"startTime"`
+	Profiles       []string       `json:"profiles"`
+	Ports          map[string]int `json:"ports"`
+}
+
+// appInfoWriter writes the application info to the file given by the procyon.infofile property once
+// the application context has been refreshed, and removes the file when the context is closed.
+type appInfoWriter struct {
+	path string
+	mu   sync.Mutex
+}
+
+// newAppInfoWriter creates a new appInfoWriter.
+func newAppInfoWriter() *appInfoWriter {
+	return &appInfoWriter{}
+}
+
+// OnEvent writes the application info file on a ContextRefreshedEvent and removes it on a
+// ContextClosedEvent. The events of the child contexts are ignored.
+func (w *appInfoWriter) OnEvent(ctx context.Context, event runtime.Event) error {
+	switch e := event.(type) {
+	case *runtime.ContextRefreshedEvent:
+		if !isRootContext(e.Context()) {
+			return nil
+		}
+
+		path := lookupString(e.Context().Environment(), AppInfoFileProp, "")
+		if path == "" {
+			return nil
+		}
+
+		info, err := newApplicationInfo(ctx, e.Context())
+		if err != nil {
+			return fmt.Errorf("write application info file %q: %w", path, err)
+		}
+
+		return w.write(path, info)
+	case *runtime.ContextClosedEvent:
+		if !isRootContext(e.Context()) {
+			return nil
+		}
+
+		w.remove()
+	}
+
+	return nil
+}
+
+// write writes the given application info as JSON to the file at the given path.
+func (w *appInfoWriter) write(path string, info *ApplicationInfo) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	content, err := json.MarshalIndent(info, "", "  ")
+	if err == nil {
+		err = writeFile(path, append(content, '\n'))
+	}
+
+	if err != nil {
+		return fmt.Errorf("write application info file %q: %w", path, err)
+	}
+
+	w.path = path
+	slog.Debug("Wrote application info file", "path", path)
+	return nil
+}
+
+// remove removes the written application info file, if any.
+func (w *appInfoWriter) remove() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.path == "" {
+		return
+	}
+
+	removeFile(w.path)
+	w.path = ""
+}
+
+// newApplicationInfo creates the application info of the given application context. The ports are
+// those of the runtime.Server components of the context, by their names.
+func newApplicationInfo(ctx context.Context, runtimeCtx runtime.Context) (*ApplicationInfo, error) {
+	env := runtimeCtx.Environment()
+
+	profiles := slices.Clone(env.ActiveProfiles())
+	slices.Sort(profiles)
+
+	info := &ApplicationInfo{
+		Name:           lookupString(env, AppNameProp, ""),
+		Version:        lookupString(env, AppVersionProp, ""),
+		ProcyonVersion: Version,
+		Pid:            os.Getpid(),
+		StartTime:      time.Now(),
+		Profiles:       profiles,
+		Ports:          make(map[string]int),
+	}
+
+	container := runtimeCtx.Container()
+	for _, definition := range container.DefinitionsOf(reflect.TypeFor[runtime.Server]()) {
+		server, err := container.Resolve(ctx, definition.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		info.Ports[definition.Name()] = server.(runtime.Server).Port()
+	}
+
+	return info, nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"codnect.io/procyon/component"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppInfoWriter(t *testing.T) {
+	// given
+	path := filepath.Join(t.TempDir(), "app.json")
+
+	serverDef, err := component.MakeDefinition(func() *anyBuilderServer { return &anyBuilderServer{} })
+	require.NoError(t, err)
+
+	app := NewBuilder().
+		WithProfiles("dev").
+		WithComponents(component.Create(serverDef)).
+		Build()
+
+	before := time.Now()
+
+	// when
+	_, err = app.Start(
+		"--procyon.infofile="+path,
+		"--procyon.application.name=anyApp",
+		"--procyon.application.version=1.2.3",
+	)
+
+	// then
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	info := &ApplicationInfo{}
+	require.NoError(t, json.Unmarshal(content, info))
+	assert.Equal(t, "anyApp", info.Name)
+	assert.Equal(t, "1.2.3", info.Version)
+	assert.Equal(t, Version, info.ProcyonVersion)
+	assert.Equal(t, os.Getpid(), info.Pid)
+	assert.Equal(t, []string{"dev"}, info.Profiles)
+	assert.Equal(t, map[string]int{serverDef.Name(): 8080}, info.Ports)
+	assert.False(t, info.StartTime.Before(before.Truncate(time.Second)))
+
+	require.NoError(t, app.Shutdown(context.Background()))
+	assert.NoFileExists(t, path)
+}
+
+func TestAppInfoWriter_WriteError(t *testing.T) {
+	// given
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o644))
+
+	path := filepath.Join(file, "app.json")
+	app := NewBuilder().WithoutServer().Build()
+
+	// when
+	runtimeCtx, err := app.Start("--procyon.infofile=" + path)
+
+	// then
+	assert.Nil(t, runtimeCtx)
+	require.ErrorContains(t, err, "write application info file \""+path+"\"")
+}
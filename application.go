@@ -18,20 +18,45 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"os"
 	"os/signal"
+	"reflect"
 	goruntime "runtime"
+	"slices"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	"codnect.io/procyon/component"
 	"codnect.io/procyon/io"
+	"codnect.io/procyon/logging"
 	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
 )
 
 const (
 	// procyonArgsContainerKey is the key used to register the procyon args in the component container.
 	procyonArgsContainerKey = "procyonAppArgs"
+	// loggingSystemContainerKey is the key used to register the logging system in the component container.
+	loggingSystemContainerKey = "procyonLoggingSystem"
+	// defaultPropertiesSourceName is the name of the property source holding the default properties.
+	defaultPropertiesSourceName = "defaultProperties"
+
+	// ApplicationTypeProp is the property key for specifying the application type explicitly.
+	ApplicationTypeProp = "procyon.main.application-type"
+)
+
+// ApplicationType represents whether the application runs as a server.
+type ApplicationType string
+
+const (
+	// NoneApplication runs without a server. The server components are not loaded, and Run returns
+	// once the command-line runners have completed.
+	NoneApplication ApplicationType = "none"
+	// ServerApplication runs as a server. Run blocks until the application receives a shutdown signal.
+	ServerApplication ApplicationType = "server"
 )
 
 // Application is the main entry point of the Procyon framework. It is responsible for initializing the application
@@ -39,32 +64,59 @@ const (
 type Application struct {
 	bannerPrinter    runtime.BannerPrinter
 	resourceResolver io.ResourceResolver
+	loggingSystem    logging.LoggingSystem
 
 	startupContainer component.Container
 	runtimeCtx       runtime.Context
 	env              runtime.Environment
 
-	envCustomizers  []*component.Component
-	ctxInitializers []*component.Component
+	envCustomizers   []*component.Component
+	ctxInitializers  []*component.Component
+	failureAnalyzers []*component.Component
 
-	envCustomizerLoadFunc  func(name string) (runtime.EnvironmentCustomizer, error)
-	ctxInitializerLoadFunc func(name string) (runtime.ContextInitializer, error)
+	envCustomizerLoadFunc   func(name string) (runtime.EnvironmentCustomizer, error)
+	ctxInitializerLoadFunc  func(name string) (runtime.ContextInitializer, error)
+	failureAnalyzerLoadFunc func(name string) (runtime.FailureAnalyzer, error)
+
+	profiles          []string
+	defaultProperties map[string]any
+	propSources       []config.PropertySource
+	components        []*component.Component
+	valueOptions      []string
+	withoutServer     bool
+	appType           ApplicationType
+
+	startupDiagnostics *StartupDiagnostics
+
+	shutdownOnce *sync.Once
+	shutdownDone chan struct{}
+	shutdownErr  error
+
+	exitCode int
 }
 
 // New creates a new instance of the application with default banner printer and resource resolver.
+// Use NewBuilder to configure the application programmatically.
 func New() *Application {
+	resourceResolver := io.NewDefaultResourceResolver()
+
 	return &Application{
-		bannerPrinter:    NewBannerPrinter(),
-		resourceResolver: io.NewDefaultResourceResolver(),
+		bannerPrinter:    NewBannerPrinter(WithBannerResourceResolver(resourceResolver)),
+		resourceResolver: resourceResolver,
+		loggingSystem:    logging.NewSlogLoggingSystem(),
 		startupContainer: component.NewStandardContainer(),
 		envCustomizers:   component.ListOf[runtime.EnvironmentCustomizer](),
 		ctxInitializers:  component.ListOf[runtime.ContextInitializer](),
+		failureAnalyzers: component.ListOf[runtime.FailureAnalyzer](),
 		envCustomizerLoadFunc: func(name string) (runtime.EnvironmentCustomizer, error) {
 			return component.Load[runtime.EnvironmentCustomizer](name)
 		},
 		ctxInitializerLoadFunc: func(name string) (runtime.ContextInitializer, error) {
 			return component.Load[runtime.ContextInitializer](name)
 		},
+		failureAnalyzerLoadFunc: func(name string) (runtime.FailureAnalyzer, error) {
+			return component.Load[runtime.FailureAnalyzer](name)
+		},
 	}
 }
 
@@ -82,11 +134,62 @@ func (a *Application) ResourceResolver() io.ResourceResolver {
 	return a.resourceResolver
 }
 
+// SetLoggingSystem sets the logging system initialized from the environment at startup.
+func (a *Application) SetLoggingSystem(loggingSystem logging.LoggingSystem) {
+	if loggingSystem == nil {
+		panic("nil logging system")
+	}
+
+	a.loggingSystem = loggingSystem
+}
+
+// LoggingSystem returns the logging system of the application.
+func (a *Application) LoggingSystem() logging.LoggingSystem {
+	return a.loggingSystem
+}
+
+// StartupDiagnostics returns the startup diagnostics of the last start. It returns nil if the
+// procyon.debug.startup property is not enabled.
+func (a *Application) StartupDiagnostics() *StartupDiagnostics {
+	return a.startupDiagnostics
+}
+
+// ExitCode returns the exit code computed by the last call to Run. If the run failed and the error
+// implements runtime.ExitCodeGenerator, its code is used. Otherwise, the codes of the ExitCodeGenerator
+// components are aggregated, falling back to 1 if the run failed.
+func (a *Application) ExitCode() int {
+	return a.exitCode
+}
+
 // Run starts the application with the given command-line arguments. It initializes the environment, prepares
-// the application context, and invokes any command-line runners defined in the application context.
-func (a *Application) Run(args ...string) (err error) {
+// the application context, and invokes any command-line runners defined in the application context. A server
+// application then blocks until it receives a shutdown signal, see Wait. The application is shut down before
+// Run returns.
+func (a *Application) Run(args ...string) error {
+	signals := notifySignals()
+	defer signal.Stop(signals)
+
+	if _, err := a.Start(args...); err != nil {
+		return err
+	}
+
+	if a.isServerApplication() {
+		return a.wait(signals)
+	}
+
+	return a.Shutdown(context.Background())
+}
+
+// Start starts the application with the given command-line arguments like Run, but returns the running
+// application context without blocking, so that the caller controls the shutdown with Wait or Shutdown.
+// If the start fails, the application is shut down and the error is returned.
+func (a *Application) Start(args ...string) (runtimeCtx runtime.Context, err error) {
 	startTime := time.Now()
 
+	a.shutdownOnce = &sync.Once{}
+	a.shutdownDone = make(chan struct{})
+	a.shutdownErr = nil
+
 	defer func() {
 		if r := recover(); r != nil {
 			switch v := r.(type) {
@@ -97,11 +200,14 @@ func (a *Application) Run(args ...string) (err error) {
 			}
 		}
 
-		err = a.close(err)
+		if err != nil {
+			err = a.shutdown(context.Background(), err)
+			runtimeCtx = nil
+		}
 	}()
 
 	var rArgs *runtime.Args
-	rArgs, err = runtime.ParseArgs(args)
+	rArgs, err = runtime.ParseArgs(args, a.valueOptions...)
 	if err != nil {
 		return
 	}
@@ -111,13 +217,25 @@ func (a *Application) Run(args ...string) (err error) {
 		return
 	}
 
-	err = a.bannerPrinter.Print(a.env, os.Stdout)
+	err = a.initializeLogging()
 	if err != nil {
 		return
 	}
 
-	signalCtx, stopSignals := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
-	defer stopSignals()
+	err = a.reportStartupDiagnostics()
+	if err != nil {
+		return
+	}
+
+	a.appType, err = resolveApplicationType(a.env, a.withoutServer)
+	if err != nil {
+		return
+	}
+
+	err = a.bannerPrinter.Print(a.env, os.Stdout)
+	if err != nil {
+		return
+	}
 
 	a.runtimeCtx, err = a.prepareRuntimeContext(rArgs)
 	if err != nil {
@@ -132,47 +250,182 @@ func (a *Application) Run(args ...string) (err error) {
 	}
 
 	timeTakenToStartup := time.Now().Sub(startTime)
-	log.Info("Started application in {} seconds", timeTakenToStartup.Seconds())
+	slog.Info("Started application", "seconds", timeTakenToStartup.Seconds())
 
 	err = a.invokeCmdLineRunners(rArgs)
 	if err != nil {
 		return
 	}
 
-	if a.isServerApplication() {
-		<-signalCtx.Done()
+	return a.runtimeCtx, nil
+}
+
+// Wait blocks until the application is shut down, either by Shutdown or by a SIGINT or SIGTERM signal,
+// in which case Wait shuts the application down itself. It returns the error of the shutdown. In the
+// meantime, the application context is reloaded whenever a SIGHUP signal is received.
+func (a *Application) Wait() error {
+	signals := notifySignals()
+	defer signal.Stop(signals)
+
+	return a.wait(signals)
+}
+
+// Shutdown closes the application context and computes the exit code. Only the first call shuts the
+// application down, the following ones return the same error. Wait returns once the shutdown is done.
+func (a *Application) Shutdown(ctx context.Context) error {
+	if ctx == nil {
+		return errors.New("nil context")
+	}
+
+	return a.shutdown(ctx, nil)
+}
+
+// wait blocks until the application is shut down, handling the signals received on the given channel.
+func (a *Application) wait(signals <-chan os.Signal) error {
+	if a.shutdownDone == nil {
+		return errors.New("application not started")
+	}
+
+	for {
+		select {
+		case <-a.shutdownDone:
+			return a.shutdownErr
+		case sig := <-signals:
+			if sig != syscall.SIGHUP {
+				return a.Shutdown(context.Background())
+			}
+
+			slog.Info("Received SIGHUP, reloading application context")
+
+			if err := a.runtimeCtx.Reload(context.Background()); err != nil {
+				slog.Error("Application context reload failed", "error", err)
+			}
+		}
+	}
+}
+
+// shutdown closes the application once, reporting the given run failure if any.
+func (a *Application) shutdown(ctx context.Context, err error) error {
+	if a.shutdownOnce == nil {
+		return a.close(ctx, err)
 	}
 
-	return
+	a.shutdownOnce.Do(func() {
+		a.shutdownErr = a.close(ctx, err)
+		close(a.shutdownDone)
+	})
+
+	return a.shutdownErr
 }
 
-// close handles application shutdown by recovering from panics, logging run failures,
-// and closing the runtime context if it is still running.
-func (a *Application) close(err error) error {
+// close handles application shutdown by reporting run failures, computing the exit code and closing
+// the runtime context if it is still running.
+func (a *Application) close(ctx context.Context, err error) error {
 	if err != nil {
-		log.Error("Application run failed", err)
+		a.reportFailure(err)
 	}
 
+	componentsExitCode := 0
+
 	if a.runtimeCtx != nil && a.runtimeCtx.IsRunning() {
-		closeErr := a.runtimeCtx.Close(context.Background())
+		componentsExitCode = a.generateExitCode()
+
+		closeErr := a.runtimeCtx.Close(ctx)
 		if closeErr != nil {
-			log.Error("Application context close failed {}", closeErr)
+			slog.Error("Application context close failed", "error", closeErr)
 			err = errors.Join(err, closeErr)
 		}
 	}
 
+	if cleanupErr := a.loggingSystem.Cleanup(); cleanupErr != nil {
+		slog.Warn("Failed to clean up logging system", "error", cleanupErr)
+	}
+
+	a.exitCode = exitCodeOf(err, componentsExitCode)
 	return err
 }
 
-// invokeCmdLineRunners retrieves all CommandLineRunner components from the application context and executes
-// them with the provided command-line arguments.
+// notifySignals returns a channel receiving the shutdown and reload signals.
+func notifySignals() chan os.Signal {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	return signals
+}
+
+// reportFailure logs the given run failure. If a FailureAnalyzer recognizes the failure,
+// its human-readable report is logged instead of the raw error chain.
+func (a *Application) reportFailure(err error) {
+	analyzers, loadErr := a.loadFailureAnalyzers()
+	if loadErr != nil {
+		slog.Warn("Failed to load failure analyzers", "error", loadErr)
+	}
+
+	for _, analyzer := range analyzers {
+		if analysis := analyzer.Analyze(err); analysis != nil {
+			slog.Error(formatFailureAnalysis(analysis))
+			return
+		}
+	}
+
+	slog.Error("Application run failed", "error", err)
+}
+
+// generateExitCode resolves all ExitCodeGenerator components from the application context
+// and aggregates their exit codes.
+func (a *Application) generateExitCode() int {
+	generators, err := component.ResolveAll[runtime.ExitCodeGenerator](a.runtimeCtx, a.runtimeCtx.Container())
+	if err != nil {
+		slog.Warn("Failed to resolve exit code generators", "error", err)
+		return 0
+	}
+
+	codes := make([]int, 0, len(generators))
+	for _, generator := range generators {
+		codes = append(codes, generator.ExitCode())
+	}
+
+	return aggregateExitCodes(codes...)
+}
+
+// invokeCmdLineRunners retrieves all CommandLineRunner components from the application context, sorts them
+// by their order and executes them with the provided command-line arguments. CommandRunner components are
+// executed only when their command is given as the first non-option argument.
 func (a *Application) invokeCmdLineRunners(args *runtime.Args) error {
 	runners, err := component.ResolveAll[runtime.CommandLineRunner](a.runtimeCtx, a.runtimeCtx.Container())
 	if err != nil {
 		return err
 	}
 
+	component.SortByOrder(runners)
+
+	command := ""
+	if nonOptArgs := args.NonOptionArgs(); len(nonOptArgs) != 0 {
+		command = nonOptArgs[0]
+	}
+
+	selected := make([]runtime.CommandLineRunner, 0, len(runners))
+	hasCmdRunners := false
+	cmdMatched := false
+
 	for _, runner := range runners {
+		if cmdRunner, ok := runner.(runtime.CommandRunner); ok {
+			hasCmdRunners = true
+
+			if cmdRunner.Command() != command {
+				continue
+			}
+
+			cmdMatched = true
+		}
+
+		selected = append(selected, runner)
+	}
+
+	if hasCmdRunners && command != "" && !cmdMatched {
+		return fmt.Errorf("unknown command %q", command)
+	}
+
+	for _, runner := range selected {
 		err = runner.Run(a.runtimeCtx, args)
 		if err != nil {
 			return err
@@ -183,23 +436,77 @@ func (a *Application) invokeCmdLineRunners(args *runtime.Args) error {
 }
 
 // prepareEnvironment initializes the application environment by creating a new environment instance, adding
-// property sources for command-line arguments and environment variables, and allowing customizers to modify
-// the environment.
+// property sources for command-line arguments, the programmatically given properties, environment variables,
+// the default properties and the random values, in this order of precedence, and allowing customizers to modify
+// the environment. The config customizer adds the .env file in the working directory right after the environment
+// variables, and the config files after it.
 func (a *Application) prepareEnvironment(args *runtime.Args) (runtime.Environment, error) {
 	env := NewEnvironment()
 
 	propertySources := env.PropertySources()
 	propertySources.PushFront(runtime.NewArgsPropertySource(args))
+
+	for _, propSource := range a.propSources {
+		propertySources.PushBack(propSource)
+	}
+
 	propertySources.PushBack(runtime.NewEnvPropertySource())
 
+	if len(a.defaultProperties) != 0 {
+		propertySources.PushBack(config.NewMapPropertySource(defaultPropertiesSourceName, a.defaultProperties))
+	}
+
+	if len(a.profiles) != 0 {
+		if err := env.SetActiveProfiles(a.profiles...); err != nil {
+			return nil, err
+		}
+	}
+
 	err := a.customizeEnv(env)
 	if err != nil {
 		return nil, err
 	}
 
+	// the customizers add the config files to the end, the default properties must stay behind them
+	if defaultProps := propertySources.Remove(defaultPropertiesSourceName); defaultProps != nil {
+		propertySources.PushBack(defaultProps)
+	}
+
+	// the random values are only used if no other source defines the key
+	propertySources.PushBack(config.NewRandomValuePropertySource())
+
 	return env, nil
 }
 
+// initializeLogging initializes the logging system with the logging properties of the environment.
+func (a *Application) initializeLogging() error {
+	cfg, err := logging.ConfigFrom(a.env)
+	if err != nil {
+		return fmt.Errorf("logging: %w", err)
+	}
+
+	if err = a.loggingSystem.Initialize(cfg); err != nil {
+		return fmt.Errorf("logging: %w", err)
+	}
+
+	return nil
+}
+
+// reportStartupDiagnostics creates and logs the startup diagnostics if they are enabled by the
+// procyon.debug.startup property.
+func (a *Application) reportStartupDiagnostics() error {
+	a.startupDiagnostics = nil
+
+	enabled, err := isStartupDebugEnabled(a.env)
+	if err != nil || !enabled {
+		return err
+	}
+
+	a.startupDiagnostics = NewStartupDiagnostics(a.env)
+	slog.Info("Startup diagnostics:\n" + a.startupDiagnostics.String())
+	return nil
+}
+
 // customizeEnv retrieves all EnvironmentCustomizer components and invokes their CustomizeEnvironment method to allow
 // them to modify the environment before it is used by the application.
 func (a *Application) customizeEnv(env runtime.Environment) error {
@@ -219,26 +526,56 @@ func (a *Application) customizeEnv(env runtime.Environment) error {
 }
 
 // prepareRuntimeContext creates the application context, allows customizers to modify it, and registers
-// the command-line arguments in the context's container.
+// the command-line arguments in the context's container. The environment is prepared again whenever the
+// context is restarted.
 func (a *Application) prepareRuntimeContext(args *runtime.Args) (runtime.Context, error) {
 	runtimeCtx := createContext(a.env, a.startupContainer, a.resourceResolver)
+	runtimeCtx.components = a.contextComponents(runtimeCtx.components)
+
+	// a restart or a reload prepares a fresh environment, so that the customizers run against new
+	// property sources
+	runtimeCtx.envProvider = func() (runtime.Environment, error) {
+		return a.prepareEnvironment(args)
+	}
 
 	err := a.initializeRuntimeContext(runtimeCtx)
 	if err != nil {
 		return nil, err
 	}
 
-	log.Info("Starting application using Go {} ({}/{})", goruntime.Version()[2:], goruntime.GOOS, goruntime.GOARCH)
-	log.Info("Running with Procyon {}", Version)
+	slog.Info("Starting application", "go", goruntime.Version()[2:], "os", goruntime.GOOS, "arch", goruntime.GOARCH)
+	slog.Info("Running with Procyon", "version", Version)
 
 	err = a.startupContainer.RegisterSingleton(procyonArgsContainerKey, args)
 	if err != nil {
 		return nil, err
 	}
 
+	err = a.startupContainer.RegisterSingleton(loggingSystemContainerKey, a.loggingSystem)
+	if err != nil {
+		return nil, err
+	}
+
 	return runtimeCtx, nil
 }
 
+// contextComponents returns the given registered components together with the programmatically given
+// components. The server components are left out if the application type is none.
+func (a *Application) contextComponents(registered []*component.Component) []*component.Component {
+	components := make([]*component.Component, 0, len(registered)+len(a.components))
+	components = append(components, registered...)
+	components = append(components, a.components...)
+
+	if a.appType != NoneApplication {
+		return components
+	}
+
+	serverType := reflect.TypeFor[runtime.Server]()
+	return slices.DeleteFunc(components, func(comp *component.Component) bool {
+		return comp.Definition().Type().ConvertibleTo(serverType)
+	})
+}
+
 // initializeRuntimeContext retrieves all ContextInitializer components and invokes their InitializeContext method to allow
 // them to modify the application context before it is refreshed and used by the application.
 func (a *Application) initializeRuntimeContext(runtimeCtx *Context) error {
@@ -257,10 +594,39 @@ func (a *Application) initializeRuntimeContext(runtimeCtx *Context) error {
 	return nil
 }
 
-// isServerApplication checks if the application is a server application by checking if there is a Server component
-// registered in the application context.
+// isServerApplication checks if the application is a server application. Unless the application type is
+// given explicitly, an application is a server application if there is a Server component registered in the
+// application context.
 func (a *Application) isServerApplication() bool {
-	return component.CanResolveType[runtime.Server](a.runtimeCtx.Container())
+	switch a.appType {
+	case NoneApplication:
+		return false
+	case ServerApplication:
+		return true
+	default:
+		return component.CanResolveType[runtime.Server](a.runtimeCtx.Container())
+	}
+}
+
+// resolveApplicationType returns the application type given by the procyon.main.application-type property.
+// It returns an empty type if it is not given, in which case the type is deduced from the components.
+func resolveApplicationType(env runtime.Environment, withoutServer bool) (ApplicationType, error) {
+	if withoutServer {
+		return NoneApplication, nil
+	}
+
+	value, ok := env.PropertyResolver().Lookup(ApplicationTypeProp)
+	if !ok {
+		return "", nil
+	}
+
+	appType := ApplicationType(strings.ToLower(strings.TrimSpace(fmt.Sprint(value))))
+	switch appType {
+	case NoneApplication, ServerApplication:
+		return appType, nil
+	default:
+		return "", fmt.Errorf("invalid application type '%s'", value)
+	}
 }
 
 // loadEnvCustomizers loads all EnvironmentCustomizer components from the application and returns them as a slice.
@@ -291,3 +657,18 @@ func (a *Application) loadCtxInitializers() ([]runtime.ContextInitializer, error
 
 	return initializers, nil
 }
+
+// loadFailureAnalyzers loads all FailureAnalyzer components from the application and returns them as a slice.
+func (a *Application) loadFailureAnalyzers() ([]runtime.FailureAnalyzer, error) {
+	var analyzers []runtime.FailureAnalyzer
+	for _, comp := range a.failureAnalyzers {
+		analyzer, err := a.failureAnalyzerLoadFunc(comp.Definition().Name())
+		if err != nil {
+			return nil, err
+		}
+
+		analyzers = append(analyzers, analyzer)
+	}
+
+	return analyzers, nil
+}
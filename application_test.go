@@ -15,14 +15,20 @@
 package procyon
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	stdio "io"
+	"log/slog"
 	"os"
+	"strings"
 	"syscall"
 	"testing"
 	"time"
 
 	"codnect.io/procyon/component"
 	"codnect.io/procyon/io"
+	"codnect.io/procyon/logging"
 	"codnect.io/procyon/runtime"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -53,7 +59,7 @@ func TestApplication_SetBannerPrinter(t *testing.T) {
 		},
 		{
 			name:      "valid printer",
-			printer:   NewBannerPrinter(),
+			printer:   NewBannerPrinter(WithBannerResourceResolver(io.NewDefaultResourceResolver())),
 			wantPanic: nil,
 		},
 	}
@@ -102,8 +108,8 @@ func TestApplication_Run(t *testing.T) {
 	}{
 		{
 			name:    "wrong argument format",
-			args:    []string{"--invalid"},
-			wantErr: errors.New("wrong argument format '--invalid'"),
+			args:    []string{"--=invalid"},
+			wantErr: errors.New("wrong argument format '--=invalid': empty option name"),
 		},
 		{
 			name:    "valid arguments",
@@ -298,6 +304,78 @@ func TestApplication_Run(t *testing.T) {
 	}
 }
 
+func TestApplication_Run_CommandLineRunners(t *testing.T) {
+	testCases := []struct {
+		name      string
+		args      []string
+		wantCalls []string
+		wantErr   error
+	}{
+		{
+			name:      "no command",
+			args:      []string{},
+			wantCalls: []string{"first", "second", "last"},
+		},
+		{
+			name:      "migrate command",
+			args:      []string{"migrate", "--dry-run=true"},
+			wantCalls: []string{"first", "migrate", "second", "last"},
+		},
+		{
+			name:      "boolean option followed by command",
+			args:      []string{"--verbose", "migrate"},
+			wantCalls: []string{"first", "migrate", "second", "last"},
+		},
+		{
+			name:      "seed command",
+			args:      []string{"seed"},
+			wantCalls: []string{"first", "second", "seed", "last"},
+		},
+		{
+			name:      "unknown command",
+			args:      []string{"backup"},
+			wantCalls: []string{},
+			wantErr:   errors.New("unknown command \"backup\""),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			app := New()
+			calls := make([]string, 0)
+
+			runners := map[string]runtime.CommandLineRunner{
+				"lastRunner":    &anyFuncCommandLineRunner{fn: func() { calls = append(calls, "last") }},
+				"secondRunner":  &anyOrderedCommandLineRunner{name: "second", order: 10, calls: &calls},
+				"firstRunner":   &anyOrderedCommandLineRunner{name: "first", order: -10, calls: &calls},
+				"seedRunner":    &anyCommandRunner{anyOrderedCommandLineRunner{name: "seed", order: 20, calls: &calls}, "seed"},
+				"migrateRunner": &anyCommandRunner{anyOrderedCommandLineRunner{name: "migrate", order: 0, calls: &calls}, "migrate"},
+			}
+
+			container := component.NewStandardContainer()
+			for name, runner := range runners {
+				err := container.RegisterSingleton(name, runner)
+				require.NoError(t, err)
+			}
+
+			app.startupContainer = container
+
+			// when
+			err := app.Run(tc.args...)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.wantCalls, calls)
+		})
+	}
+}
+
 func TestApplication_Run_ServerApplication(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -355,3 +433,299 @@ func TestApplication_Run_ServerApplication(t *testing.T) {
 		})
 	}
 }
+
+func TestApplication_Run_ApplicationType(t *testing.T) {
+	testCases := []struct {
+		name       string
+		args       []string
+		server     bool
+		wantServer bool
+		wantErr    error
+	}{
+		{
+			name:   "none application with server",
+			args:   []string{"--procyon.main.application-type=none"},
+			server: true,
+		},
+		{
+			name:       "server application without server",
+			args:       []string{"--procyon.main.application-type=server"},
+			wantServer: true,
+		},
+		{
+			name:       "deduced server application",
+			server:     true,
+			wantServer: true,
+		},
+		{
+			name: "deduced none application",
+		},
+		{
+			name:    "invalid application type",
+			args:    []string{"--procyon.main.application-type=anyType"},
+			wantErr: errors.New("invalid application type 'anyType'"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			app := New()
+
+			container := component.NewStandardContainer()
+			if tc.server {
+				err := container.RegisterSingleton("anyServerApp", &AnyMockServerApp{})
+				require.NoError(t, err)
+			}
+
+			app.startupContainer = container
+
+			// when
+			_, err := app.Start(tc.args...)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantServer, app.isServerApplication())
+			require.NoError(t, app.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestApplication_Start(t *testing.T) {
+	// given
+	app := New()
+
+	container := component.NewStandardContainer()
+	err := container.RegisterSingleton("anyServerApp", &AnyMockServerApp{})
+	require.NoError(t, err)
+
+	app.startupContainer = container
+
+	// when
+	runtimeCtx, err := app.Start()
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, runtimeCtx)
+	assert.True(t, runtimeCtx.IsRunning())
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = app.Shutdown(context.Background())
+	}()
+
+	waitErr := make(chan error, 1)
+	go func() {
+		waitErr <- app.Wait()
+	}()
+
+	select {
+	case err = <-waitErr:
+		assert.NoError(t, err)
+	case <-time.After(3 * time.Second):
+		t.Fatal("Wait did not return in time")
+	}
+
+	assert.False(t, runtimeCtx.IsRunning())
+	assert.NoError(t, app.Shutdown(context.Background()))
+	assert.Equal(t, 0, app.ExitCode())
+}
+
+func TestApplication_StartError(t *testing.T) {
+	// given
+	app := New()
+
+	// when
+	runtimeCtx, err := app.Start("--=invalid")
+
+	// then
+	assert.Nil(t, runtimeCtx)
+	require.EqualError(t, err, "wrong argument format '--=invalid': empty option name")
+	assert.Equal(t, 1, app.ExitCode())
+	assert.EqualError(t, app.Wait(), "wrong argument format '--=invalid': empty option name")
+}
+
+func TestApplication_Wait(t *testing.T) {
+	// given
+	app := New()
+
+	// when
+	err := app.Wait()
+
+	// then
+	require.EqualError(t, err, "application not started")
+}
+
+func TestApplication_Shutdown(t *testing.T) {
+	// given
+	app := New()
+
+	// when
+	err := app.Shutdown(nil)
+
+	// then
+	require.EqualError(t, err, "nil context")
+}
+
+func TestApplication_Start_Logging(t *testing.T) {
+	testCases := []struct {
+		name       string
+		args       []string
+		wantLevels map[string]logging.Level
+		wantErr    error
+	}{
+		{
+			name:       "logging levels",
+			args:       []string{"--logging.level.root=warn", "--logging.level.codnect.io/procyon=debug"},
+			wantLevels: map[string]logging.Level{"root": logging.LevelWarn, "codnect.io/procyon": logging.LevelDebug},
+		},
+		{
+			name:    "invalid logging format",
+			args:    []string{"--logging.format=xml"},
+			wantErr: errors.New("logging: invalid logging.format 'xml'"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			app := New()
+
+			// when
+			runtimeCtx, err := app.Start(tc.args...)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			defer app.Shutdown(context.Background())
+
+			loggingSystem, err := component.ResolveType[logging.LoggingSystem](context.Background(), runtimeCtx.Container())
+			require.NoError(t, err)
+			assert.Same(t, app.LoggingSystem(), loggingSystem)
+			assert.Equal(t, tc.wantLevels, loggingSystem.Levels())
+		})
+	}
+}
+
+func TestApplication_Start_FrameworkLogLevels(t *testing.T) {
+	testCases := []struct {
+		name    string
+		args    []string
+		wantLog bool
+	}{
+		{
+			name:    "info level by default",
+			wantLog: true,
+		},
+		{
+			name: "warn level for framework package",
+			args: []string{"--logging.level.codnect.io/procyon=warn"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var buf bytes.Buffer
+			loggingSystem := logging.NewSlogLoggingSystem()
+			loggingSystem.SetHandlerFunc(func(w stdio.Writer, opts *slog.HandlerOptions) slog.Handler {
+				return slog.NewTextHandler(&buf, opts)
+			})
+
+			app := New()
+			app.SetLoggingSystem(loggingSystem)
+
+			// when
+			_, err := app.Start(tc.args...)
+
+			// then
+			require.NoError(t, err)
+			defer app.Shutdown(context.Background())
+
+			assert.Equal(t, tc.wantLog, strings.Contains(buf.String(), "Starting application"))
+		})
+	}
+}
+
+func TestApplication_Start_StartupDiagnostics(t *testing.T) {
+	testCases := []struct {
+		name            string
+		args            []string
+		wantDiagnostics bool
+		wantActive      ProfilesDescriptor
+		wantDefault     ProfilesDescriptor
+		wantErr         error
+	}{
+		{
+			name: "disabled by default",
+		},
+		{
+			name:            "enabled",
+			args:            []string{"--procyon.debug.startup=true", "--procyon.profiles.active=dev"},
+			wantDiagnostics: true,
+			wantActive:      ProfilesDescriptor{Profiles: []string{"dev"}, Origin: "command-line argument #2"},
+			wantDefault:     ProfilesDescriptor{Profiles: []string{"default"}, Origin: "built-in"},
+		},
+		{
+			name:    "invalid value",
+			args:    []string{"--procyon.debug.startup=yes"},
+			wantErr: errors.New("invalid procyon.debug.startup 'yes'"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			app := New()
+
+			// when
+			_, err := app.Start(tc.args...)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			defer app.Shutdown(context.Background())
+
+			diagnostics := app.StartupDiagnostics()
+			if !tc.wantDiagnostics {
+				assert.Nil(t, diagnostics)
+				return
+			}
+
+			require.NotNil(t, diagnostics)
+			assert.Equal(t, tc.wantActive, diagnostics.ActiveProfiles)
+			assert.Equal(t, tc.wantDefault, diagnostics.DefaultProfiles)
+			require.NotEmpty(t, diagnostics.PropertySources)
+			assert.Equal(t, PropertySourceDescriptor{Name: "commandLineArgs", Origin: "commandLineArgs"}, diagnostics.PropertySources[0])
+			assert.Contains(t, diagnostics.SkippedLocations, "resources/procyon-dev.yaml")
+		})
+	}
+}
+
+func TestApplication_SetLoggingSystem(t *testing.T) {
+	// given
+	app := New()
+	loggingSystem := logging.NewSlogLoggingSystem()
+
+	// when
+	app.SetLoggingSystem(loggingSystem)
+
+	// then
+	assert.Same(t, loggingSystem, app.LoggingSystem())
+	assert.PanicsWithValue(t, "nil logging system", func() {
+		app.SetLoggingSystem(nil)
+	})
+}
@@ -15,41 +15,341 @@
 package procyon
 
 import (
+	"bytes"
+	"context"
 	"fmt"
-	"io"
+	goio "io"
+	"log/slog"
+	"os"
+	goruntime "runtime"
+	"slices"
+	"strings"
+	"text/template"
 
+	"codnect.io/procyon/io"
 	"codnect.io/procyon/runtime"
 )
 
+const (
+	// BannerLocationProp is the property key for specifying the location of the banner template.
+	BannerLocationProp = "procyon.banner.location"
+	// BannerModeProp is the property key for specifying where the banner is printed.
+	BannerModeProp = "procyon.banner.mode"
+	// BannerColorProp is the property key for specifying whether the ANSI colors of the banner are enabled.
+	BannerColorProp = "procyon.banner.color"
+
+	// AppNameProp is the property key for specifying the name of the application.
+	AppNameProp = "procyon.application.name"
+	// AppVersionProp is the property key for specifying the version of the application.
+	AppVersionProp = "procyon.application.version"
+)
+
+// BannerMode represents where the banner is printed.
+type BannerMode string
+
+const (
+	// BannerConsole prints the banner to the writer given to the banner printer.
+	BannerConsole BannerMode = "console"
+	// BannerLog prints the banner to the log.
+	BannerLog BannerMode = "log"
+	// BannerOff disables the banner.
+	BannerOff BannerMode = "off"
+)
+
+// BannerColor represents whether the ANSI colors of the banner are enabled.
+type BannerColor string
+
+const (
+	// BannerColorDetect enables the colors if the banner is printed to a terminal and the
+	// NO_COLOR environment variable is not set.
+	BannerColorDetect BannerColor = "detect"
+	// BannerColorAlways always enables the colors.
+	BannerColorAlways BannerColor = "always"
+	// BannerColorNever always disables the colors.
+	BannerColorNever BannerColor = "never"
+)
+
 var (
-	bannerText = []string{
-		"   ___  _______ ______ _____  ___\n",
-		"  / _ \\/ __/ _ / __/ // / _ \\/ _ \\\n",
-		" / .__/_/  \\___\\__/\\_, /\\___/_//_/\n",
-		"/_/               /___/\n",
+	// defaultBanner is the template of the banner printed if no banner location is specified.
+	defaultBanner = "   ___  _______ ______ _____  ___\n" +
+		"  / _ \\/ __/ _ / __/ // / _ \\/ _ \\\n" +
+		" / .__/_/  \\___\\__/\\_, /\\___/_//_/\n" +
+		"/_/               /___/\n" +
+		"{{ printf \"%24s%s)\" \"(\" .Version }}\n"
+
+	// ansiCodes contains the ANSI escape codes that can be used in banner templates.
+	ansiCodes = map[string]string{
+		"reset":          "\033[0m",
+		"bold":           "\033[1m",
+		"faint":          "\033[2m",
+		"italic":         "\033[3m",
+		"underline":      "\033[4m",
+		"black":          "\033[30m",
+		"red":            "\033[31m",
+		"green":          "\033[32m",
+		"yellow":         "\033[33m",
+		"blue":           "\033[34m",
+		"magenta":        "\033[35m",
+		"cyan":           "\033[36m",
+		"white":          "\033[37m",
+		"default":        "\033[39m",
+		"bright_black":   "\033[90m",
+		"bright_red":     "\033[91m",
+		"bright_green":   "\033[92m",
+		"bright_yellow":  "\033[93m",
+		"bright_blue":    "\033[94m",
+		"bright_magenta": "\033[95m",
+		"bright_cyan":    "\033[96m",
+		"bright_white":   "\033[97m",
+		"bg_black":       "\033[40m",
+		"bg_red":         "\033[41m",
+		"bg_green":       "\033[42m",
+		"bg_yellow":      "\033[43m",
+		"bg_blue":        "\033[44m",
+		"bg_magenta":     "\033[45m",
+		"bg_cyan":        "\033[46m",
+		"bg_white":       "\033[47m",
+		"bg_default":     "\033[49m",
 	}
-	versionFormat = "%24s%s)\n"
 )
 
+// BannerData is the data the banner template is executed with.
+type BannerData struct {
+	// AppName is the name of the application, specified by the procyon.application.name property.
+	AppName string
+	// AppVersion is the version of the application, specified by the procyon.application.version property.
+	AppVersion string
+	// Version is the version of Procyon.
+	Version string
+	// GoVersion is the version of Go the application is built with.
+	GoVersion string
+	// Profiles are the active profiles of the environment.
+	Profiles []string
+
+	env runtime.Environment
+}
+
+// Property returns the value of the given property, or an empty string if it does not exist.
+func (d *BannerData) Property(name string) string {
+	if d.env == nil {
+		return ""
+	}
+
+	value, ok := d.env.PropertyResolver().Lookup(name)
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprint(value)
+}
+
+// BannerPrinter prints the banner of the application. The banner is a text/template loaded from the
+// location specified by the procyon.banner.location property, or the Procyon banner by default. The
+// template is executed with BannerData and can use the following functions:
+//
+//	ansi "name"          ANSI escape code such as red, bright_green, bg_blue, bold or reset
+//	join .Profiles ", "  joins the given strings with the separator
+//
+// For instance, {{ ansi "green" }}{{ .AppName }}{{ ansi "reset" }} {{ .Property "server.port" }}.
 type BannerPrinter struct {
+	resourceResolver io.ResourceResolver
 }
 
-func NewBannerPrinter() *BannerPrinter {
-	return &BannerPrinter{}
+// BannerPrinterOption is a functional option used to configure a BannerPrinter.
+type BannerPrinterOption func(printer *BannerPrinter)
+
+// WithBannerResourceResolver sets the resource resolver loading the banner templates.
+func WithBannerResourceResolver(resolver io.ResourceResolver) BannerPrinterOption {
+	if resolver == nil {
+		panic("nil resource resolver")
+	}
+
+	return func(printer *BannerPrinter) {
+		printer.resourceResolver = resolver
+	}
 }
 
-func (b *BannerPrinter) Print(_ runtime.Environment, w io.Writer) error {
-	for _, line := range bannerText {
-		_, err := w.Write([]byte(line))
-		if err != nil {
-			return fmt.Errorf("print banner: %w", err)
-		}
+// NewBannerPrinter creates a new banner printer. The banner templates are loaded with the default resource
+// resolver, unless another one is given with WithBannerResourceResolver.
+func NewBannerPrinter(opts ...BannerPrinterOption) *BannerPrinter {
+	printer := &BannerPrinter{
+		resourceResolver: io.NewDefaultResourceResolver(),
 	}
 
-	_, err := w.Write([]byte(fmt.Sprintf(versionFormat, "(", Version)))
-	if err != nil {
+	for _, opt := range opts {
+		opt(printer)
+	}
+
+	return printer
+}
+
+// Print renders the banner and prints it according to the procyon.banner.mode property: to the given
+// writer in the console mode, which is the default, to the log in the log mode, or nowhere in the off mode.
+func (b *BannerPrinter) Print(env runtime.Environment, w goio.Writer) error {
+	if err := b.print(env, w); err != nil {
 		return fmt.Errorf("print banner: %w", err)
 	}
 
 	return nil
 }
+
+// print renders and prints the banner.
+func (b *BannerPrinter) print(env runtime.Environment, w goio.Writer) error {
+	mode := BannerMode(lookupString(env, BannerModeProp, string(BannerConsole)))
+	switch mode {
+	case BannerOff:
+		return nil
+	case BannerConsole, BannerLog:
+	default:
+		return fmt.Errorf("invalid banner mode '%s'", mode)
+	}
+
+	colors, err := b.colorsEnabled(env, mode, w)
+	if err != nil {
+		return err
+	}
+
+	text, err := b.loadTemplate(env)
+	if err != nil {
+		return err
+	}
+
+	banner, err := renderBanner(text, newBannerData(env), colors)
+	if err != nil {
+		return err
+	}
+
+	if mode == BannerLog {
+		slog.Info(strings.TrimRight(banner, "\n"))
+		return nil
+	}
+
+	_, err = goio.WriteString(w, banner)
+	return err
+}
+
+// colorsEnabled reports whether the ANSI colors are enabled based on the procyon.banner.color property.
+func (b *BannerPrinter) colorsEnabled(env runtime.Environment, mode BannerMode, w goio.Writer) (bool, error) {
+	color := BannerColor(lookupString(env, BannerColorProp, string(BannerColorDetect)))
+	switch color {
+	case BannerColorAlways:
+		return true, nil
+	case BannerColorNever:
+		return false, nil
+	case BannerColorDetect:
+		return mode == BannerConsole && isTerminal(w) && os.Getenv("NO_COLOR") == "" && os.Getenv("TERM") != "dumb", nil
+	default:
+		return false, fmt.Errorf("invalid banner color '%s'", color)
+	}
+}
+
+// loadTemplate loads the banner template from the location specified by the procyon.banner.location
+// property. It returns the default banner if no location is specified.
+func (b *BannerPrinter) loadTemplate(env runtime.Environment) (string, error) {
+	location := lookupString(env, BannerLocationProp, "")
+	if location == "" {
+		return defaultBanner, nil
+	}
+
+	resource, err := b.resourceResolver.Resolve(context.Background(), location)
+	if err != nil {
+		return "", err
+	}
+
+	if !resource.Exists() {
+		return "", fmt.Errorf("banner location %q: resource not found", location)
+	}
+
+	reader, err := resource.Reader()
+	if err != nil {
+		return "", fmt.Errorf("banner location %q: %w", location, err)
+	}
+	defer reader.Close()
+
+	content, err := goio.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("banner location %q: %w", location, err)
+	}
+
+	return string(content), nil
+}
+
+// newBannerData creates the data the banner template is executed with.
+func newBannerData(env runtime.Environment) *BannerData {
+	data := &BannerData{
+		Version:   Version,
+		GoVersion: goruntime.Version(),
+		Profiles:  []string{},
+		env:       env,
+	}
+
+	if env != nil {
+		data.AppName = lookupString(env, AppNameProp, "")
+		data.AppVersion = lookupString(env, AppVersionProp, "")
+		data.Profiles = slices.Sorted(slices.Values(env.ActiveProfiles()))
+	}
+
+	return data
+}
+
+// renderBanner executes the given banner template. The ansi function returns an empty string if the
+// colors are disabled.
+func renderBanner(text string, data *BannerData, colors bool) (string, error) {
+	funcs := template.FuncMap{
+		"ansi": func(name string) (string, error) {
+			code, ok := ansiCodes[name]
+			if !ok {
+				return "", fmt.Errorf("unknown ansi code '%s'", name)
+			}
+
+			if !colors {
+				return "", nil
+			}
+
+			return code, nil
+		},
+		"join": strings.Join,
+	}
+
+	tmpl, err := template.New("banner").Funcs(funcs).Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// lookupString returns the string value of the given property, or the default value if the environment
+// is nil or the property does not exist.
+func lookupString(env runtime.Environment, name, defaultValue string) string {
+	if env == nil {
+		return defaultValue
+	}
+
+	value, ok := env.PropertyResolver().Lookup(name)
+	if !ok {
+		return defaultValue
+	}
+
+	return strings.TrimSpace(fmt.Sprint(value))
+}
+
+// isTerminal reports whether the given writer is a terminal.
+func isTerminal(w goio.Writer) bool {
+	file, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
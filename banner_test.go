@@ -15,74 +15,197 @@
 package procyon
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
-	"strings"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
 	"testing"
 
+	"codnect.io/procyon/io"
+	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
-func TestBannerPrinter_PrintBanner(t *testing.T) {
-	testCases := []struct {
-		name         string
-		preCondition func(writer *AnyMockWriter)
+const defaultBannerOutput = "   ___  _______ ______ _____  ___\n" +
+	"  / _ \\/ __/ _ / __/ // / _ \\/ _ \\\n" +
+	" / .__/_/  \\___\\__/\\_, /\\___/_//_/\n" +
+	"/_/               /___/\n" +
+	"                       (" + Version + ")\n"
+
+func TestNewBannerPrinter(t *testing.T) {
+	// given
+	resolver := io.NewDefaultResourceResolver()
+
+	// when
+	defaultPrinter := NewBannerPrinter()
+	printer := NewBannerPrinter(WithBannerResourceResolver(resolver))
+
+	// then
+	assert.NotNil(t, defaultPrinter.resourceResolver)
+	assert.Same(t, resolver, printer.resourceResolver)
+}
+
+func TestWithBannerResourceResolver(t *testing.T) {
+	assert.PanicsWithValue(t, "nil resource resolver", func() {
+		WithBannerResourceResolver(nil)
+	})
+}
+
+func TestBannerPrinter_Print(t *testing.T) {
+	dir := t.TempDir()
+
+	writeBanner := func(t *testing.T, content string) string {
+		path := filepath.Join(dir, "banner.txt")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+		return "file:" + path
+	}
 
+	testCases := []struct {
+		name       string
+		banner     string
+		props      map[string]any
+		profiles   []string
+		nilEnv     bool
 		wantOutput string
 		wantErr    error
 	}{
 		{
-			name: "no error",
-			preCondition: func(writer *AnyMockWriter) {
-				writer.On("Write", mock.Anything).Return(0, nil)
-			},
-			wantOutput: strings.Join(bannerText, "") + fmt.Sprintf(versionFormat, "(", Version),
+			name:       "default banner without environment",
+			nilEnv:     true,
+			wantOutput: defaultBannerOutput,
 		},
 		{
-			name: "text write error",
-			preCondition: func(writer *AnyMockWriter) {
-				writer.On("Write", mock.Anything).Return(0, errors.New("write error"))
-			},
-			wantErr:    errors.New("print banner: write error"),
-			wantOutput: "",
+			name:       "default banner",
+			wantOutput: defaultBannerOutput,
+		},
+		{
+			name:       "console mode",
+			props:      map[string]any{BannerModeProp: "console"},
+			wantOutput: defaultBannerOutput,
+		},
+		{
+			name:  "log mode",
+			props: map[string]any{BannerModeProp: "log"},
+		},
+		{
+			name:  "off mode",
+			props: map[string]any{BannerModeProp: "off"},
+		},
+		{
+			name:    "invalid mode",
+			props:   map[string]any{BannerModeProp: "anyMode"},
+			wantErr: errors.New("print banner: invalid banner mode 'anyMode'"),
+		},
+		{
+			name:    "invalid color",
+			props:   map[string]any{BannerColorProp: "anyColor"},
+			wantErr: errors.New("print banner: invalid banner color 'anyColor'"),
 		},
 		{
-			name: "version write error",
-			preCondition: func(writer *AnyMockWriter) {
-				for _, line := range bannerText {
-					writer.On("Write", []byte(line)).Return(len(line), nil).Once()
-				}
-
-				writer.On("Write", []byte(fmt.Sprintf(versionFormat, "(", Version))).
-					Return(0, errors.New("write error"))
+			name:   "custom banner with placeholders",
+			banner: "{{ .AppName }} {{ .AppVersion }} {{ .Version }} {{ .GoVersion }} {{ join .Profiles \",\" }} {{ .Property \"server.port\" }}{{ .Property \"missing\" }}",
+			props: map[string]any{
+				AppNameProp:    "anyApp",
+				AppVersionProp: "1.0.0",
+				"server.port":  8080,
 			},
-			wantErr:    errors.New("print banner: write error"),
-			wantOutput: strings.Join(bannerText, ""),
+			profiles:   []string{"prod", "eu"},
+			wantOutput: fmt.Sprintf("anyApp 1.0.0 %s %s eu,prod 8080", Version, goruntime.Version()),
+		},
+		{
+			name:       "colors enabled",
+			banner:     "{{ ansi \"bright_green\" }}{{ .AppName }}{{ ansi \"reset\" }}",
+			props:      map[string]any{AppNameProp: "anyApp", BannerColorProp: "always"},
+			wantOutput: "\033[92manyApp\033[0m",
+		},
+		{
+			name:       "colors disabled",
+			banner:     "{{ ansi \"bright_green\" }}{{ .AppName }}{{ ansi \"reset\" }}",
+			props:      map[string]any{AppNameProp: "anyApp", BannerColorProp: "never"},
+			wantOutput: "anyApp",
+		},
+		{
+			name:       "colors detected",
+			banner:     "{{ ansi \"red\" }}{{ .AppName }}",
+			props:      map[string]any{AppNameProp: "anyApp"},
+			wantOutput: "anyApp",
+		},
+		{
+			name:    "unknown ansi code",
+			banner:  "{{ ansi \"purple\" }}",
+			wantErr: errors.New("print banner: template: banner:1:3: executing \"banner\" at <ansi \"purple\">: error calling ansi: unknown ansi code 'purple'"),
+		},
+		{
+			name:    "invalid template",
+			banner:  "{{ .AppName ",
+			wantErr: errors.New("print banner: template: banner:1: unclosed action"),
+		},
+		{
+			name:    "missing banner",
+			props:   map[string]any{BannerLocationProp: "file:" + filepath.Join(dir, "missing.txt")},
+			wantErr: fmt.Errorf("print banner: banner location %q: resource not found", "file:"+filepath.Join(dir, "missing.txt")),
+		},
+		{
+			name:    "unsupported banner location",
+			props:   map[string]any{BannerLocationProp: "ftp://anyHost/banner.txt"},
+			wantErr: errors.New("print banner: resolve resource \"ftp://anyHost/banner.txt\": unsupported scheme \"ftp\""),
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// given
-			anyWriter := &AnyMockWriter{}
-			if tc.preCondition != nil {
-				tc.preCondition(anyWriter)
+			props := map[string]any{}
+			for key, value := range tc.props {
+				props[key] = value
+			}
+
+			if tc.banner != "" {
+				props[BannerLocationProp] = writeBanner(t, tc.banner)
+			}
+
+			var env runtime.Environment
+			if !tc.nilEnv {
+				environment := NewEnvironment()
+				environment.PropertySources().PushBack(config.NewMapPropertySource("anyProps", props))
+				require.NoError(t, environment.SetActiveProfiles(tc.profiles...))
+				env = environment
 			}
 
+			var buf bytes.Buffer
 			bannerPrinter := NewBannerPrinter()
 
 			// when
-			err := bannerPrinter.Print(nil, anyWriter)
+			err := bannerPrinter.Print(env, &buf)
 
 			// then
 			if tc.wantErr != nil {
-				require.Error(t, err)
 				require.EqualError(t, err, tc.wantErr.Error())
+				return
 			}
 
-			assert.Equal(t, tc.wantOutput, anyWriter.String())
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOutput, buf.String())
 		})
 	}
 }
+
+func TestBannerPrinter_PrintWriteError(t *testing.T) {
+	// given
+	anyWriter := &AnyMockWriter{}
+	anyWriter.On("Write", mock.Anything).Return(0, errors.New("write error"))
+
+	bannerPrinter := NewBannerPrinter()
+
+	// when
+	err := bannerPrinter.Print(nil, anyWriter)
+
+	// then
+	require.EqualError(t, err, "print banner: write error")
+	assert.Empty(t, anyWriter.String())
+}
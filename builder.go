@@ -0,0 +1,174 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"maps"
+	"slices"
+
+	"codnect.io/procyon/component"
+	"codnect.io/procyon/io"
+	"codnect.io/procyon/logging"
+	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
+)
+
+// Builder configures an application programmatically, without environment variables or command-line
+// arguments. It is mostly useful for tests and applications embedding Procyon.
+//
+//	err := procyon.NewBuilder().
+//		WithProfiles("dev").
+//		WithDefaultProperties(map[string]any{"server.port": 8081}).
+//		WithoutServer().
+//		Run(os.Args[1:]...)
+type Builder struct {
+	profiles          []string
+	defaultProperties map[string]any
+	propSources       []config.PropertySource
+	bannerPrinter     runtime.BannerPrinter
+	resourceResolver  io.ResourceResolver
+	loggingSystem     logging.LoggingSystem
+	components        []*component.Component
+	valueOptions      []string
+	withoutServer     bool
+}
+
+// NewBuilder creates a new application builder.
+func NewBuilder() *Builder {
+	return &Builder{
+		defaultProperties: map[string]any{},
+	}
+}
+
+// WithProfiles sets the active profiles of the application. The procyon.profiles.active property
+// takes precedence over them if it is given.
+func (b *Builder) WithProfiles(profiles ...string) *Builder {
+	b.profiles = append(b.profiles, profiles...)
+	return b
+}
+
+// WithDefaultProperties adds properties with the lowest precedence, which are used only if no other
+// property source defines them.
+func (b *Builder) WithDefaultProperties(props map[string]any) *Builder {
+	if props == nil {
+		panic("nil map")
+	}
+
+	maps.Copy(b.defaultProperties, props)
+	return b
+}
+
+// WithProperties adds property sources that take precedence over the environment variables and the
+// config files, but not over the command-line arguments. They are consulted in the order they are given.
+func (b *Builder) WithProperties(propSources ...config.PropertySource) *Builder {
+	for _, propSource := range propSources {
+		if propSource == nil {
+			panic("nil property source")
+		}
+	}
+
+	b.propSources = append(b.propSources, propSources...)
+	return b
+}
+
+// WithBanner sets the banner printer of the application.
+func (b *Builder) WithBanner(printer runtime.BannerPrinter) *Builder {
+	if printer == nil {
+		panic("nil printer")
+	}
+
+	b.bannerPrinter = printer
+	return b
+}
+
+// WithResourceResolver sets the resource resolver used to load the config files and the banner.
+func (b *Builder) WithResourceResolver(resolver io.ResourceResolver) *Builder {
+	if resolver == nil {
+		panic("nil resource resolver")
+	}
+
+	b.resourceResolver = resolver
+	return b
+}
+
+// WithLoggingSystem sets the logging system initialized from the environment at startup.
+func (b *Builder) WithLoggingSystem(loggingSystem logging.LoggingSystem) *Builder {
+	if loggingSystem == nil {
+		panic("nil logging system")
+	}
+
+	b.loggingSystem = loggingSystem
+	return b
+}
+
+// WithComponents adds components that are loaded into the application context in addition to the
+// registered components.
+func (b *Builder) WithComponents(components ...*component.Component) *Builder {
+	for _, comp := range components {
+		if comp == nil {
+			panic("nil component")
+		}
+	}
+
+	b.components = append(b.components, components...)
+	return b
+}
+
+// WithValueOptions sets the command-line options that take the next argument as their value, as in
+// --port 8080. The other options take a value only in the --name=value form, so that a boolean option
+// followed by a command, as in --verbose migrate, is not mistaken for an option with a value.
+func (b *Builder) WithValueOptions(names ...string) *Builder {
+	b.valueOptions = append(b.valueOptions, names...)
+	return b
+}
+
+// WithoutServer runs the application with the none application type regardless of the
+// procyon.main.application-type property. The server components are left out of the application context,
+// and the application does not block waiting for a shutdown signal once it has started.
+func (b *Builder) WithoutServer() *Builder {
+	b.withoutServer = true
+	return b
+}
+
+// Build creates the application configured by the builder.
+func (b *Builder) Build() *Application {
+	app := New()
+
+	if b.resourceResolver != nil {
+		app.resourceResolver = b.resourceResolver
+		app.bannerPrinter = NewBannerPrinter(WithBannerResourceResolver(b.resourceResolver))
+	}
+
+	if b.bannerPrinter != nil {
+		app.bannerPrinter = b.bannerPrinter
+	}
+
+	if b.loggingSystem != nil {
+		app.loggingSystem = b.loggingSystem
+	}
+
+	app.profiles = slices.Clone(b.profiles)
+	app.defaultProperties = maps.Clone(b.defaultProperties)
+	app.propSources = slices.Clone(b.propSources)
+	app.components = slices.Clone(b.components)
+	app.valueOptions = slices.Clone(b.valueOptions)
+	app.withoutServer = b.withoutServer
+	return app
+}
+
+// Run builds the application and runs it with the given command-line arguments.
+func (b *Builder) Run(args ...string) error {
+	return b.Build().Run(args...)
+}
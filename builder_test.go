@@ -0,0 +1,194 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"context"
+	"testing"
+
+	"codnect.io/procyon/component"
+	"codnect.io/procyon/io"
+	"codnect.io/procyon/logging"
+	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type anyBuilderServer struct{}
+
+func (s *anyBuilderServer) Start(ctx context.Context) error {
+	return nil
+}
+
+func (s *anyBuilderServer) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (s *anyBuilderServer) Port() int {
+	return 8080
+}
+
+func TestBuilder_Panics(t *testing.T) {
+	testCases := []struct {
+		name      string
+		fn        func(builder *Builder)
+		wantPanic string
+	}{
+		{
+			name:      "nil default properties",
+			fn:        func(builder *Builder) { builder.WithDefaultProperties(nil) },
+			wantPanic: "nil map",
+		},
+		{
+			name:      "nil property source",
+			fn:        func(builder *Builder) { builder.WithProperties(nil) },
+			wantPanic: "nil property source",
+		},
+		{
+			name:      "nil banner printer",
+			fn:        func(builder *Builder) { builder.WithBanner(nil) },
+			wantPanic: "nil printer",
+		},
+		{
+			name:      "nil resource resolver",
+			fn:        func(builder *Builder) { builder.WithResourceResolver(nil) },
+			wantPanic: "nil resource resolver",
+		},
+		{
+			name:      "nil logging system",
+			fn:        func(builder *Builder) { builder.WithLoggingSystem(nil) },
+			wantPanic: "nil logging system",
+		},
+		{
+			name:      "nil component",
+			fn:        func(builder *Builder) { builder.WithComponents(nil) },
+			wantPanic: "nil component",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.PanicsWithValue(t, tc.wantPanic, func() {
+				tc.fn(NewBuilder())
+			})
+		})
+	}
+}
+
+func TestBuilder_Build(t *testing.T) {
+	// given
+	resolver := io.NewDefaultResourceResolver()
+	propSource := config.NewMapPropertySource("anyProps", map[string]any{})
+	def, err := component.MakeDefinition(func() *anyBuilderServer { return &anyBuilderServer{} })
+	require.NoError(t, err)
+	comp := component.Create(def)
+
+	// when
+	app := NewBuilder().
+		WithProfiles("dev").
+		WithDefaultProperties(map[string]any{"anyKey": "anyValue"}).
+		WithProperties(propSource).
+		WithResourceResolver(resolver).
+		WithComponents(comp).
+		WithValueOptions("port").
+		WithoutServer().
+		Build()
+
+	// then
+	assert.Same(t, resolver, app.ResourceResolver())
+	assert.Same(t, resolver, app.bannerPrinter.(*BannerPrinter).resourceResolver)
+	assert.Equal(t, []string{"dev"}, app.profiles)
+	assert.Equal(t, map[string]any{"anyKey": "anyValue"}, app.defaultProperties)
+	assert.Equal(t, []config.PropertySource{propSource}, app.propSources)
+	assert.Equal(t, []*component.Component{comp}, app.components)
+	assert.Equal(t, []string{"port"}, app.valueOptions)
+	assert.True(t, app.withoutServer)
+}
+
+func TestBuilder_BuildWithBanner(t *testing.T) {
+	// given
+	printer := &AnyMockBannerPrinter{}
+
+	loggingSystem := logging.NewSlogLoggingSystem()
+
+	// when
+	app := NewBuilder().
+		WithBanner(printer).
+		WithLoggingSystem(loggingSystem).
+		WithResourceResolver(io.NewDefaultResourceResolver()).
+		Build()
+
+	// then
+	assert.Same(t, printer, app.bannerPrinter)
+	assert.Same(t, loggingSystem, app.LoggingSystem())
+}
+
+func TestBuilder_PrepareEnvironment(t *testing.T) {
+	// given
+	app := NewBuilder().
+		WithProfiles("dev", "local").
+		WithDefaultProperties(map[string]any{
+			"app.default": "default",
+			"app.props":   "default",
+		}).
+		WithProperties(config.NewMapPropertySource("anyProps", map[string]any{
+			"app.props": "props",
+			"app.args":  "props",
+		})).
+		Build()
+
+	args, err := runtime.ParseArgs([]string{"--app.args=args"})
+	require.NoError(t, err)
+
+	// when
+	env, err := app.prepareEnvironment(args)
+
+	// then
+	require.NoError(t, err)
+
+	resolver := env.PropertyResolver()
+	assert.Equal(t, "default", resolver.LookupOrDefault("app.default", nil))
+	assert.Equal(t, "props", resolver.LookupOrDefault("app.props", nil))
+	assert.Equal(t, "args", resolver.LookupOrDefault("app.args", nil))
+	assert.ElementsMatch(t, []string{"dev", "local"}, env.ActiveProfiles())
+
+	sources := env.PropertySources().Slice()
+	assert.Equal(t, defaultPropertiesSourceName, sources[len(sources)-2].Name())
+	assert.Equal(t, config.RandomPropertySourceName, sources[len(sources)-1].Name())
+}
+
+func TestBuilder_Run(t *testing.T) {
+	// given
+	calls := make([]string, 0)
+
+	runnerDef, err := component.MakeDefinition(func() *anyOrderedCommandLineRunner {
+		return &anyOrderedCommandLineRunner{name: "builder", calls: &calls}
+	})
+	require.NoError(t, err)
+
+	serverDef, err := component.MakeDefinition(func() *anyBuilderServer { return &anyBuilderServer{} })
+	require.NoError(t, err)
+
+	// when
+	err = NewBuilder().
+		WithComponents(component.Create(runnerDef), component.Create(serverDef)).
+		WithoutServer().
+		Run()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"builder"}, calls)
+}
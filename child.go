@@ -0,0 +1,176 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"codnect.io/procyon/component"
+	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
+)
+
+// ChildBuilder builds a child application context of a running context. The container of the child
+// resolves the components it does not define from the container of the parent, and the property sources
+// of the child are layered over the property sources of the parent. The child is closed before its parent.
+type ChildBuilder struct {
+	parent      runtime.Context
+	profiles    []string
+	propSources []config.PropertySource
+	components  []*component.Component
+}
+
+// NewChild creates a new builder for a child context of the given parent context.
+func NewChild(parent runtime.Context) *ChildBuilder {
+	if parent == nil {
+		panic("nil parent context")
+	}
+
+	return &ChildBuilder{
+		parent: parent,
+	}
+}
+
+// WithProfiles sets the active profiles of the child context. If no profile is given, the child
+// inherits the active profiles of the parent. The profile-specific configuration files of the profiles,
+// such as procyon-<profile>.yaml, and the documents of the base configuration files activated on them are
+// loaded from the configuration locations of the parent, unless the parent has loaded them already. They
+// take precedence over the property sources of the parent, but not over the property sources of the child.
+func (b *ChildBuilder) WithProfiles(profiles ...string) *ChildBuilder {
+	b.profiles = append(b.profiles, profiles...)
+	return b
+}
+
+// WithProperties adds property sources specific to the child context. They take precedence over the
+// property sources of the parent, in the order they are given.
+func (b *ChildBuilder) WithProperties(propSources ...config.PropertySource) *ChildBuilder {
+	for _, propSource := range propSources {
+		if propSource == nil {
+			panic("nil property source")
+		}
+	}
+
+	b.propSources = append(b.propSources, propSources...)
+	return b
+}
+
+// WithComponents adds components that are defined only in the child context. The components registered
+// globally are not loaded into the child again, as they are already available from the parent.
+func (b *ChildBuilder) WithComponents(components ...*component.Component) *ChildBuilder {
+	for _, comp := range components {
+		if comp == nil {
+			panic("nil component")
+		}
+	}
+
+	b.components = append(b.components, components...)
+	return b
+}
+
+// Run creates the child context and refreshes it. The child is registered with its parent, so that
+// closing the parent closes the child first. Reloading or restarting the child prepares its environment
+// again from the current environment of the parent.
+func (b *ChildBuilder) Run() (runtime.Context, error) {
+	parent, ok := b.parent.(*Context)
+	if !ok {
+		return nil, fmt.Errorf("unsupported parent context type %T", b.parent)
+	}
+
+	if !parent.isActive() {
+		return nil, errors.New("parent context not refreshed or closed")
+	}
+
+	env, err := b.prepareEnvironment(parent)
+	if err != nil {
+		return nil, fmt.Errorf("prepare child environment: %w", err)
+	}
+
+	processor, err := component.MakeDefinition(newConfigPropertiesProcessor)
+	if err != nil {
+		return nil, fmt.Errorf("create child config properties processor: %w", err)
+	}
+
+	// the config properties processor of the parent binds the properties from the parent environment, so
+	// the child has its own processor binding the properties of the child components from the child environment
+	child := createContext(env, parent.Container(), parent.ResourceResolver())
+	child.components = append(slices.Clone(b.components), component.Create(processor))
+	child.parent = parent
+	child.envProvider = func() (runtime.Environment, error) {
+		return b.prepareEnvironment(parent)
+	}
+
+	parent.addChild(child)
+
+	if err = child.Refresh(context.Background()); err != nil {
+		parent.removeChild(child)
+		return nil, err
+	}
+
+	return child, nil
+}
+
+// prepareEnvironment creates the environment of the child. The property sources of the child come first,
+// followed by the configuration loaded for the profiles of the child and the property sources of the parent
+// that are not overridden by a source with the same name.
+func (b *ChildBuilder) prepareEnvironment(parent *Context) (*Environment, error) {
+	parentEnv := parent.Environment()
+	env := NewEnvironment()
+
+	propSources := env.PropertySources()
+	for _, propSource := range b.propSources {
+		propSources.PushBack(propSource)
+	}
+
+	childSourceCount := propSources.Len()
+
+	for _, propSource := range parentEnv.PropertySources().Slice() {
+		if !propSources.Has(propSource.Name()) {
+			propSources.PushBack(propSource)
+		}
+	}
+
+	if err := env.SetDefaultProfiles(parentEnv.DefaultProfiles()...); err != nil {
+		return nil, err
+	}
+
+	activeProfiles := b.profiles
+	if len(activeProfiles) == 0 {
+		activeProfiles = parentEnv.ActiveProfiles()
+	}
+
+	if len(activeProfiles) != 0 {
+		if err := env.SetActiveProfiles(activeProfiles...); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(b.profiles) != 0 {
+		profileSources, err := newConfigEnvCustomizer().loadProfileConfig(env, parent.ResourceResolver(), b.profiles)
+		if err != nil {
+			return nil, fmt.Errorf("load config for child profiles: %w", err)
+		}
+
+		index := childSourceCount
+		for _, propSource := range profileSources {
+			propSources.Insert(index, propSource)
+			index++
+		}
+	}
+
+	return env, nil
+}
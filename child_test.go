@@ -0,0 +1,402 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"codnect.io/procyon/component"
+	"codnect.io/procyon/io"
+	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type anyParentService struct{}
+
+type anyChildService struct {
+	parent *anyParentService
+}
+
+type anyChildProperties struct {
+	Name string `property:"name"`
+}
+
+func (p *anyChildProperties) Prefix() string {
+	return "plugin"
+}
+
+func newAnyChildComponent(t *testing.T, fn component.ConstructorFunc, name string) *component.Component {
+	def, err := component.MakeDefinition(fn, component.WithName(name))
+	require.NoError(t, err)
+	return component.Create(def)
+}
+
+func newAnyParentContext(t *testing.T, events *[]string) *Context {
+	env := NewEnvironment()
+	env.PropertySources().PushBack(config.NewMapPropertySource("parentProps", map[string]any{
+		"app.name":  "parent",
+		"app.owner": "parent",
+	}))
+	require.NoError(t, env.SetActiveProfiles("dev"))
+
+	startupContainer := component.NewStandardContainer()
+	require.NoError(t, startupContainer.RegisterSingleton("parentService", &anyParentService{}))
+	require.NoError(t, startupContainer.RegisterSingleton("parentListener", &anyEventListener{
+		name:   "parentListener",
+		events: events,
+	}))
+
+	ctx := createContext(env, startupContainer, io.NewDefaultResourceResolver())
+	require.NoError(t, ctx.Refresh(context.Background()))
+	return ctx
+}
+
+func TestNewChild(t *testing.T) {
+	assert.PanicsWithValue(t, "nil parent context", func() {
+		NewChild(nil)
+	})
+
+	assert.PanicsWithValue(t, "nil property source", func() {
+		NewChild(&Context{}).WithProperties(nil)
+	})
+
+	assert.PanicsWithValue(t, "nil component", func() {
+		NewChild(&Context{}).WithComponents(nil)
+	})
+}
+
+func TestChildBuilder_Run(t *testing.T) {
+	testCases := []struct {
+		name    string
+		parent  func(t *testing.T) runtime.Context
+		wantErr error
+	}{
+		{
+			name: "unsupported parent",
+			parent: func(t *testing.T) runtime.Context {
+				return struct{ runtime.Context }{}
+			},
+			wantErr: errors.New("unsupported parent context type struct { runtime.Context }"),
+		},
+		{
+			name: "non-refreshed parent",
+			parent: func(t *testing.T) runtime.Context {
+				return createContext(NewEnvironment(), component.NewStandardContainer(), io.NewDefaultResourceResolver())
+			},
+			wantErr: errors.New("parent context not refreshed or closed"),
+		},
+		{
+			name: "closed parent",
+			parent: func(t *testing.T) runtime.Context {
+				ctx := newAnyParentContext(t, &[]string{})
+				require.NoError(t, ctx.Close(context.Background()))
+				return ctx
+			},
+			wantErr: errors.New("parent context not refreshed or closed"),
+		},
+		{
+			name: "invalid profile",
+			parent: func(t *testing.T) runtime.Context {
+				return newAnyParentContext(t, &[]string{})
+			},
+			wantErr: errors.New("prepare child environment: invalid profile: empty or blank profile"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			builder := NewChild(tc.parent(t)).WithProfiles(" ")
+
+			// when
+			child, err := builder.Run()
+
+			// then
+			assert.Nil(t, child)
+			assert.EqualError(t, err, tc.wantErr.Error())
+		})
+	}
+}
+
+func TestChildBuilder_RunResolvesFromParent(t *testing.T) {
+	// given
+	parent := newAnyParentContext(t, &[]string{})
+	defer parent.Close(context.Background())
+
+	childComponent := newAnyChildComponent(t, func(parent *anyParentService) *anyChildService {
+		return &anyChildService{parent: parent}
+	}, "childService")
+
+	// when
+	child, err := NewChild(parent).WithComponents(childComponent).Run()
+
+	// then
+	require.NoError(t, err)
+	assert.Same(t, parent, child.(*Context).Parent())
+
+	service, err := component.ResolveType[*anyChildService](context.Background(), child.Container())
+	require.NoError(t, err)
+	assert.NotNil(t, service.parent)
+
+	assert.False(t, component.CanResolveType[*anyChildService](parent.Container()))
+}
+
+func TestChildBuilder_RunBindsChildProperties(t *testing.T) {
+	// given
+	parent := newAnyParentContext(t, &[]string{})
+	defer parent.Close(context.Background())
+
+	childComponent := newAnyChildComponent(t, func() *anyChildProperties {
+		return &anyChildProperties{}
+	}, "childProperties")
+
+	// when
+	child, err := NewChild(parent).
+		WithProperties(config.NewMapPropertySource("childProps", map[string]any{
+			"plugin.name": "child",
+		})).
+		WithComponents(childComponent).
+		Run()
+
+	// then
+	require.NoError(t, err)
+
+	props, err := component.ResolveType[*anyChildProperties](context.Background(), child.Container())
+	require.NoError(t, err)
+	assert.Equal(t, "child", props.Name)
+}
+
+func TestChildBuilder_RunLayersProperties(t *testing.T) {
+	testCases := []struct {
+		name         string
+		profiles     []string
+		propSources  []config.PropertySource
+		wantName     string
+		wantOwner    string
+		wantProfiles []string
+	}{
+		{
+			name:         "inherited properties and profiles",
+			wantName:     "parent",
+			wantOwner:    "parent",
+			wantProfiles: []string{"dev"},
+		},
+		{
+			name:     "child properties and profiles",
+			profiles: []string{"plugin"},
+			propSources: []config.PropertySource{
+				config.NewMapPropertySource("childProps", map[string]any{
+					"app.name": "child",
+				}),
+			},
+			wantName:     "child",
+			wantOwner:    "parent",
+			wantProfiles: []string{"plugin"},
+		},
+		{
+			name: "overridden parent source",
+			propSources: []config.PropertySource{
+				config.NewMapPropertySource("parentProps", map[string]any{
+					"app.name": "child",
+				}),
+			},
+			wantName:     "child",
+			wantProfiles: []string{"dev"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			parent := newAnyParentContext(t, &[]string{})
+			defer parent.Close(context.Background())
+
+			// when
+			child, err := NewChild(parent).
+				WithProfiles(tc.profiles...).
+				WithProperties(tc.propSources...).
+				Run()
+
+			// then
+			require.NoError(t, err)
+
+			resolver := child.Environment().PropertyResolver()
+			assert.Equal(t, tc.wantName, resolver.LookupOrDefault("app.name", ""))
+			assert.Equal(t, tc.wantOwner, resolver.LookupOrDefault("app.owner", ""))
+			assert.Equal(t, tc.wantProfiles, child.Environment().ActiveProfiles())
+
+			parentResolver := parent.Environment().PropertyResolver()
+			assert.Equal(t, "parent", parentResolver.LookupOrDefault("app.name", ""))
+			assert.Equal(t, []string{"dev"}, parent.Environment().ActiveProfiles())
+		})
+	}
+}
+
+func TestChildBuilder_RunLoadsProfileConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		files       map[string]string
+		profiles    []string
+		propSources []config.PropertySource
+		wantName    string
+		wantOwner   string
+	}{
+		{
+			name:      "profile-specific config file",
+			files:     map[string]string{"procyon-plugin.properties": "app.name=plugin"},
+			profiles:  []string{"plugin"},
+			wantName:  "plugin",
+			wantOwner: "parent",
+		},
+		{
+			name: "base config document activated on profile",
+			files: map[string]string{
+				"procyon.yaml": "app:\n  owner: base\n---\nprocyon.config.activate.on-profile: plugin\napp:\n  owner: plugin\n",
+			},
+			profiles:  []string{"plugin"},
+			wantName:  "parent",
+			wantOwner: "plugin",
+		},
+		{
+			name:     "child properties over profile-specific config file",
+			files:    map[string]string{"procyon-plugin.properties": "app.name=plugin\napp.owner=plugin"},
+			profiles: []string{"plugin"},
+			propSources: []config.PropertySource{
+				config.NewMapPropertySource("childProps", map[string]any{
+					"app.name": "child",
+				}),
+			},
+			wantName:  "child",
+			wantOwner: "plugin",
+		},
+		{
+			name:      "inherited profiles",
+			files:     map[string]string{"procyon-plugin.properties": "app.name=plugin"},
+			wantName:  "parent",
+			wantOwner: "parent",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			dir := t.TempDir()
+			for file, content := range tc.files {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte(content), 0o644))
+			}
+
+			parent := newAnyParentContext(t, &[]string{})
+			defer parent.Close(context.Background())
+			parent.Environment().PropertySources().PushFront(config.NewMapPropertySource("locationProps", map[string]any{
+				ConfigLocationProp: "file:" + dir + "/",
+			}))
+
+			// when
+			child, err := NewChild(parent).
+				WithProfiles(tc.profiles...).
+				WithProperties(tc.propSources...).
+				Run()
+
+			// then
+			require.NoError(t, err)
+
+			resolver := child.Environment().PropertyResolver()
+			assert.Equal(t, tc.wantName, resolver.LookupOrDefault("app.name", ""))
+			assert.Equal(t, tc.wantOwner, resolver.LookupOrDefault("app.owner", ""))
+			assert.Equal(t, "parent", parent.Environment().PropertyResolver().LookupOrDefault("app.name", ""))
+		})
+	}
+}
+
+func TestChildBuilder_RunReloadsChild(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	file := filepath.Join(dir, "procyon-plugin.properties")
+	require.NoError(t, os.WriteFile(file, []byte("app.name=plugin"), 0o644))
+
+	parent := newAnyParentContext(t, &[]string{})
+	defer parent.Close(context.Background())
+	parent.Environment().PropertySources().PushFront(config.NewMapPropertySource("locationProps", map[string]any{
+		ConfigLocationProp: "file:" + dir + "/",
+	}))
+
+	child, err := NewChild(parent).WithProfiles("plugin").Run()
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(file, []byte("app.name=reloaded"), 0o644))
+
+	// when
+	err = child.Reload(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "reloaded", child.Environment().PropertyResolver().LookupOrDefault("app.name", ""))
+	assert.Equal(t, "parent", parent.Environment().PropertyResolver().LookupOrDefault("app.name", ""))
+}
+
+func TestContext_CloseClosesChildrenFirst(t *testing.T) {
+	// given
+	events := make([]string, 0)
+	parent := newAnyParentContext(t, &events)
+
+	listenerComponent := newAnyChildComponent(t, func() *anyEventListener {
+		return &anyEventListener{name: "childListener", order: -1, events: &events}
+	}, "childListener")
+
+	first, err := NewChild(parent).WithComponents(listenerComponent).Run()
+	require.NoError(t, err)
+
+	second, err := NewChild(parent).Run()
+	require.NoError(t, err)
+
+	events = events[:0]
+
+	// when
+	err = parent.Close(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.ErrorIs(t, first.Err(), context.Canceled)
+	assert.ErrorIs(t, second.Err(), context.Canceled)
+	assert.Empty(t, parent.children)
+	assert.Equal(t, []string{
+		"childListener *runtime.ContextClosedEvent",
+		"parentListener *runtime.ContextClosedEvent",
+	}, events)
+}
+
+func TestContext_CloseDeregistersChild(t *testing.T) {
+	// given
+	parent := newAnyParentContext(t, &[]string{})
+	defer parent.Close(context.Background())
+
+	child, err := NewChild(parent).Run()
+	require.NoError(t, err)
+
+	// when
+	err = child.Close(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, parent.children)
+	assert.True(t, parent.isActive())
+	assert.True(t, parent.IsRunning())
+}
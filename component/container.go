@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"maps"
 	"reflect"
 	"slices"
@@ -365,7 +366,7 @@ func (d *StandardContainer) destroySingleton(name string, destroyed map[string]s
 
 	if disposable, ok := singleton.(Disposable); ok {
 		if err := disposable.Dispose(); err != nil {
-			log.Warn("Failed to dispose singleton '{}'", name, err)
+			slog.Warn("Failed to dispose singleton", "component", name, "error", err)
 		}
 	}
 }
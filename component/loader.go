@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 )
 
 // Loader defines the interface responsible for loading registered components.
@@ -64,7 +65,7 @@ func (l *ConditionalLoader) Load(ctx context.Context) error {
 
 		if !l.evaluator.evaluate(ctx, comp.Conditions()) {
 			skipped = append(skipped, comp)
-			log.Debug("skipping component %s due to unsatisfied conditions", def.Name())
+			slog.Debug("Skipping component due to unsatisfied conditions", "component", def.Name())
 			continue
 		}
 
@@ -0,0 +1,61 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"math"
+	"slices"
+)
+
+const (
+	// HighestPrecedence is the order value of the components that should come first.
+	HighestPrecedence = math.MinInt
+	// LowestPrecedence is the order value of the components that should come last.
+	LowestPrecedence = math.MaxInt
+)
+
+// Ordered can be implemented by components that need to be processed in a specific order.
+// Components with lower order values are processed first.
+type Ordered interface {
+	// Order returns the order value of the component.
+	Order() int
+}
+
+// OrderOf returns the order value of the given instance. Instances that do not implement
+// Ordered have the lowest precedence.
+func OrderOf(instance any) int {
+	if ordered, ok := instance.(Ordered); ok {
+		return ordered.Order()
+	}
+
+	return LowestPrecedence
+}
+
+// SortByOrder sorts the given instances by their order values. Instances with the same
+// order value keep their original relative order.
+func SortByOrder[T any](instances []T) {
+	slices.SortStableFunc(instances, func(a, b T) int {
+		orderA, orderB := OrderOf(a), OrderOf(b)
+
+		switch {
+		case orderA < orderB:
+			return -1
+		case orderA > orderB:
+			return 1
+		default:
+			return 0
+		}
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type anyOrderedComponent struct {
+	name  string
+	order int
+}
+
+func (c *anyOrderedComponent) Order() int {
+	return c.order
+}
+
+func TestOrderOf(t *testing.T) {
+	testCases := []struct {
+		name      string
+		instance  any
+		wantOrder int
+	}{
+		{
+			name:      "ordered instance",
+			instance:  &anyOrderedComponent{order: 5},
+			wantOrder: 5,
+		},
+		{
+			name:      "non-ordered instance",
+			instance:  &AnyPointerComponent{},
+			wantOrder: LowestPrecedence,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+
+			// when
+			order := OrderOf(tc.instance)
+
+			// then
+			assert.Equal(t, tc.wantOrder, order)
+		})
+	}
+}
+
+func TestSortByOrder(t *testing.T) {
+	// given
+	first := &anyOrderedComponent{name: "first", order: HighestPrecedence}
+	second := &anyOrderedComponent{name: "second", order: -1}
+	third := &anyOrderedComponent{name: "third", order: 10}
+	fourth := &anyOrderedComponent{name: "fourth", order: 10}
+	last := &AnyPointerComponent{}
+
+	instances := []any{last, third, fourth, second, first}
+
+	// when
+	SortByOrder(instances)
+
+	// then
+	assert.Equal(t, []any{first, second, third, fourth, last}, instances)
+}
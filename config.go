@@ -24,26 +24,29 @@ import (
 // configPropertiesProcessor binds configuration properties to components
 // that implement the config.Properties interface.
 type configPropertiesProcessor struct {
-	env runtime.Environment
+	env        runtime.Environment
+	converters []config.Converter
 }
 
 // newConfigPropertiesProcessor creates a new configPropertiesProcessor
-// using the given environment as the property source.
-func newConfigPropertiesProcessor(env runtime.Environment) *configPropertiesProcessor {
+// using the given environment as the property source and the given
+// converters in addition to the built-in ones.
+func newConfigPropertiesProcessor(env runtime.Environment, converters []config.Converter) *configPropertiesProcessor {
 	if env == nil {
 		panic("nil environment")
 	}
 
 	return &configPropertiesProcessor{
-		env: env,
+		env:        env,
+		converters: converters,
 	}
 }
 
 // ProcessAfterInit binds configuration properties to the given component
 // if it implements the config.Properties interface.
-func (c *configPropertiesProcessor) ProcessAfterInit(_ context.Context, instance any) (any, error) {
+func (c *configPropertiesProcessor) ProcessAfterInit(_ context.Context, _ string, instance any) (any, error) {
 	if properties, ok := instance.(config.Properties); ok {
-		binder := config.NewDefaultPropertyBinder(c.env.PropertySources())
+		binder := config.NewDefaultPropertyBinder(c.env.PropertySources(), c.converters...)
 
 		if err := binder.Bind(properties.Prefix(), properties); err != nil {
 			return nil, err
@@ -51,5 +54,4 @@ func (c *configPropertiesProcessor) ProcessAfterInit(_ context.Context, instance
 	}
 
 	return instance, nil
-
 }
@@ -0,0 +1,70 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"codnect.io/procyon/component"
+	"codnect.io/procyon/io"
+	"codnect.io/procyon/runtime/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type anyBoundProperties struct {
+	Port int `property:"port"`
+}
+
+func (p *anyBoundProperties) Prefix() string {
+	return "bound"
+}
+
+func TestConfigPropertiesProcessor_ImplementsAfterInitProcessor(t *testing.T) {
+	// given
+	processorType := reflect.TypeFor[*configPropertiesProcessor]()
+
+	// when
+	implements := processorType.Implements(reflect.TypeFor[component.AfterInitProcessor]())
+
+	// then
+	assert.True(t, implements)
+}
+
+func TestContext_RefreshBindsConfigProperties(t *testing.T) {
+	// given
+	env := NewEnvironment()
+	env.PropertySources().PushBack(config.NewMapPropertySource("anyProps", map[string]any{"bound.port": 8080}))
+
+	ctx := createContext(env, component.NewStandardContainer(), io.NewDefaultResourceResolver())
+
+	def, err := component.MakeDefinition(func() *anyBoundProperties {
+		return &anyBoundProperties{}
+	})
+	require.NoError(t, err)
+	ctx.components = append(ctx.components, component.Create(def))
+
+	// when
+	err = ctx.Refresh(context.Background())
+
+	// then
+	require.NoError(t, err)
+
+	props, err := component.ResolveType[*anyBoundProperties](context.Background(), ctx.Container())
+	require.NoError(t, err)
+	assert.Equal(t, 8080, props.Port)
+}
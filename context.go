@@ -18,7 +18,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"reflect"
+	"slices"
 	"sync"
 	"time"
 
@@ -41,6 +43,7 @@ var (
 	bootstrapTypes = []reflect.Type{
 		reflect.TypeFor[runtime.EnvironmentCustomizer](),
 		reflect.TypeFor[runtime.ContextInitializer](),
+		reflect.TypeFor[runtime.FailureAnalyzer](),
 	}
 )
 
@@ -73,11 +76,18 @@ type Context struct {
 
 	resourceResolver  io.ResourceResolver
 	env               runtime.Environment
+	envProvider       func() (runtime.Environment, error)
 	containerProvider func() component.Container
 
 	components       []*component.Component
 	container        component.Container
 	lifecycleManager runtime.LifecycleManager
+
+	parent   *Context
+	children []*Context
+	childMu  sync.Mutex
+	// lifecycleMu serializes the restarts and reloads of the context
+	lifecycleMu sync.Mutex
 }
 
 // createContext creates a new application context with the given environment.
@@ -115,7 +125,10 @@ func (c *Context) Deadline() (deadline time.Time, ok bool) {
 }
 
 // Done method returns a channel that's closed when work done on behalf of this context should be canceled.
+// A restart replaces the channel of a closed context.
 func (c *Context) Done() <-chan struct{} {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.done
 }
 
@@ -210,18 +223,158 @@ func (c *Context) IsRunning() bool {
 }
 
 // Refresh initializes the application context by preparing the container, loading component definitions,
-// initializing singleton components, and starting lifecycle management.
+// initializing singleton components, and starting lifecycle management. A ContextRefreshedEvent is published
+// once the context has been refreshed.
 func (c *Context) Refresh(ctx context.Context) error {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	err := c.doRefresh(ctx)
+	c.mu.Unlock()
 
-	if err := c.doRefresh(ctx); err != nil {
+	if err != nil {
 		return &contextError{Op: "refresh", Err: err}
 	}
 
+	if err = c.PublishEvent(ctx, runtime.NewContextRefreshedEvent(c)); err != nil {
+		return &contextError{Op: "refresh", Err: err}
+	}
+
+	return nil
+}
+
+// Restart closes the current container if the context is active, prepares the environment again, which
+// re-runs the environment customizers, and refreshes a fresh container. The component conditions are
+// evaluated again against the new environment, and the startup container is reused as the parent of the
+// new container. A closed context can be restarted as well.
+//
+// A ContextRestartingEvent and a ContextClosedEvent are published before the current container is closed,
+// and a ContextRefreshedEvent and a ContextRestartedEvent after the new one is refreshed. A listener of the
+// ContextRestartingEvent can prevent the restart by returning an error.
+//
+// A restart waits for the reload or restart in progress, if any, to complete.
+func (c *Context) Restart(ctx context.Context) error {
+	if ctx == nil {
+		return &contextError{Op: "restart", Err: errors.New("nil context")}
+	}
+
+	c.lifecycleMu.Lock()
+	defer c.lifecycleMu.Unlock()
+
+	return c.restart(ctx, nil)
+}
+
+// restart restarts the context with the given environment. The environment is prepared again with the
+// environment provider if it is nil.
+func (c *Context) restart(ctx context.Context, env runtime.Environment) error {
+	if c.isActive() {
+		if err := c.PublishEvent(ctx, runtime.NewContextRestartingEvent(c)); err != nil {
+			return &contextError{Op: "restart", Err: err}
+		}
+
+		c.publishClosedEvent(ctx)
+	}
+
+	childErr := c.closeChildren(ctx)
+
+	c.mu.Lock()
+	err := errors.Join(childErr, c.doRestart(ctx, env))
+	c.mu.Unlock()
+
+	if err != nil {
+		return &contextError{Op: "restart", Err: err}
+	}
+
+	if err = c.PublishEvent(ctx, runtime.NewContextRefreshedEvent(c)); err != nil {
+		return &contextError{Op: "restart", Err: err}
+	}
+
+	if err = c.PublishEvent(ctx, runtime.NewContextRestartedEvent(c)); err != nil {
+		return &contextError{Op: "restart", Err: err}
+	}
+
+	return nil
+}
+
+// doRestart closes the current container if the context is active, reopens the context if it has been
+// closed, prepares the environment unless it is given and refreshes the context.
+func (c *Context) doRestart(ctx context.Context, env runtime.Environment) error {
+	if c.refreshed && c.err == nil {
+		if err := c.doClose(ctx); err != nil {
+			return err
+		}
+	}
+
+	if c.err != nil {
+		c.done = make(chan struct{})
+		c.err = nil
+	}
+
+	c.refreshed = false
+
+	if env == nil && c.envProvider != nil {
+		var err error
+		env, err = c.envProvider()
+		if err != nil {
+			return fmt.Errorf("prepare environment: %w", err)
+		}
+	}
+
+	if env != nil {
+		c.env = env
+	}
+
+	return c.doRefresh(ctx)
+}
+
+// PublishEvent notifies the EventListener components of the context of the given event, in their order.
+// It returns the error of the first listener that fails.
+func (c *Context) PublishEvent(ctx context.Context, event runtime.Event) error {
+	if ctx == nil {
+		return errors.New("nil context")
+	}
+
+	if event == nil {
+		return errors.New("nil event")
+	}
+
+	c.mu.RLock()
+	container := c.container
+	c.mu.RUnlock()
+
+	if container == nil {
+		return errors.New("context not refreshed")
+	}
+
+	listeners, err := component.ResolveAll[runtime.EventListener](ctx, container)
+	if err != nil {
+		return fmt.Errorf("resolve event listeners: %w", err)
+	}
+
+	component.SortByOrder(listeners)
+
+	for _, listener := range listeners {
+		if err = listener.OnEvent(ctx, event); err != nil {
+			return fmt.Errorf("publish event %T: %w", event, err)
+		}
+	}
+
 	return nil
 }
 
+// isActive reports whether the context has been refreshed and not closed yet.
+func (c *Context) isActive() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.container != nil && c.err == nil
+}
+
+// publishClosedEvent publishes a ContextClosedEvent. As the context is closed regardless of the
+// listeners, their errors are only logged.
+func (c *Context) publishClosedEvent(ctx context.Context) {
+	if err := c.PublishEvent(ctx, runtime.NewContextClosedEvent(c)); err != nil {
+		slog.Warn("Failed to publish context closed event", "error", err)
+	}
+}
+
 // doRefresh initializes the application context. It prepares the container, loads component definitions, initializes
 // singleton components, resolves the lifecycle manager, and starts it.
 func (c *Context) doRefresh(ctx context.Context) (err error) {
@@ -246,7 +399,7 @@ func (c *Context) doRefresh(ctx context.Context) (err error) {
 		}
 
 		if err != nil {
-			log.Warn("Cancelling application context refresh attempt due to error: {}", err)
+			slog.Warn("Cancelling application context refresh attempt", "error", err)
 			err = errors.Join(err, c.cancelRefresh(ctx))
 		}
 	}()
@@ -389,6 +542,12 @@ func (c *Context) resolveLifecycleManager(ctx context.Context) error {
 		return err
 	}
 
+	// the lifecycle manager of the parent is visible through the parent container, but a child context
+	// manages its own lifecycle components
+	if manager != nil && c.parent != nil && manager == c.parent.currentLifecycleManager() {
+		manager = nil
+	}
+
 	if manager != nil {
 		c.lifecycleManager = manager
 	} else if c.lifecycleManager == nil {
@@ -399,18 +558,74 @@ func (c *Context) resolveLifecycleManager(ctx context.Context) error {
 }
 
 // Close stops the application context, destroys all singleton components, releases resources, and marks
-// the context as canceled.
+// the context as canceled. The child contexts are closed first, and a ContextClosedEvent is published before
+// the components are destroyed.
 func (c *Context) Close(ctx context.Context) error {
+	childErr := c.closeChildren(ctx)
+
+	if ctx != nil && c.isActive() {
+		c.publishClosedEvent(ctx)
+	}
+
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	err := errors.Join(childErr, c.doClose(ctx))
+	c.mu.Unlock()
+
+	if c.parent != nil {
+		c.parent.removeChild(c)
+	}
 
-	if err := c.doClose(ctx); err != nil {
+	if err != nil {
 		return &contextError{Op: "close", Err: err}
 	}
 
 	return nil
 }
 
+// Parent returns the parent of the context, or nil if the context is not a child context.
+func (c *Context) Parent() runtime.Context {
+	if c.parent == nil {
+		return nil
+	}
+
+	return c.parent
+}
+
+// addChild registers the given context as a child, so that it is closed together with the context.
+func (c *Context) addChild(child *Context) {
+	c.childMu.Lock()
+	defer c.childMu.Unlock()
+	c.children = append(c.children, child)
+}
+
+// removeChild deregisters the given child context.
+func (c *Context) removeChild(child *Context) {
+	c.childMu.Lock()
+	defer c.childMu.Unlock()
+
+	if index := slices.Index(c.children, child); index != -1 {
+		c.children = slices.Delete(c.children, index, index+1)
+	}
+}
+
+// closeChildren closes the child contexts in the reverse order of their creation. The children are closed
+// before the context is locked, as their components may still resolve from the container of the context.
+func (c *Context) closeChildren(ctx context.Context) error {
+	c.childMu.Lock()
+	children := c.children
+	c.children = nil
+	c.childMu.Unlock()
+
+	var err error
+	for i := len(children) - 1; i >= 0; i-- {
+		if closeErr := children[i].Close(ctx); closeErr != nil && !errors.Is(closeErr, context.Canceled) {
+			err = errors.Join(err, fmt.Errorf("close child context: %w", closeErr))
+		}
+	}
+
+	return err
+}
+
 // doClose stops lifecycle management, destroys singleton components, and marks the context as canceled.
 func (c *Context) doClose(ctx context.Context) error {
 	if c.err != nil {
@@ -455,6 +670,13 @@ func (c *Context) ResourceResolver() io.ResourceResolver {
 	return c.resourceResolver
 }
 
+// currentLifecycleManager returns the lifecycle manager of the context.
+func (c *Context) currentLifecycleManager() runtime.LifecycleManager {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lifecycleManager
+}
+
 // startLifecycleManager starts the lifecycle manager if it exists and is not already running.
 func (c *Context) startLifecycleManager(ctx context.Context) error {
 	if c.lifecycleManager != nil && !c.lifecycleManager.IsRunning() {
@@ -24,6 +24,7 @@ import (
 	"codnect.io/procyon/component"
 	"codnect.io/procyon/io"
 	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -464,6 +465,14 @@ func TestContext_IsRunning(t *testing.T) {
 	}
 }
 
+type anyValidatedProperties struct {
+	Port int `property:"port" validate:"min=1"`
+}
+
+func (p *anyValidatedProperties) Prefix() string {
+	return "app"
+}
+
 func TestContext_Refresh(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -583,6 +592,33 @@ func TestContext_Refresh(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "invalid config properties",
+			preCondition: func(ctx *Context, startupContainer component.Container) {
+				ctx.Environment().PropertySources().PushBack(config.NewMapPropertySource("appProps", map[string]any{
+					"app.port": 0,
+				}))
+
+				container := component.NewStandardContainer()
+
+				def, err := component.MakeDefinition(func() *anyValidatedProperties {
+					return &anyValidatedProperties{}
+				})
+				require.NoError(t, err)
+
+				err = container.RegisterDefinition(def)
+				require.NoError(t, err)
+
+				ctx.containerProvider = func() component.Container {
+					container.SetParentContainer(startupContainer)
+					return container
+				}
+			},
+			wantErr: errors.New("refresh context: initialize singleton \"anyValidatedProperties\": resolve \"anyValidatedProperties\": " +
+				"initialize \"anyValidatedProperties\" (*procyon.anyValidatedProperties): apply after-init processors: " +
+				"after-init processor (*procyon.configPropertiesProcessor): bind property \"app\" to procyon.anyValidatedProperties: " +
+				"invalid properties: app.port: must be at least 1, but was '0' (from appProps)"),
+		},
 		{
 			name: "refresh successfully",
 			preCondition: func(ctx *Context, startupContainer component.Container) {
@@ -705,6 +741,277 @@ func TestContext_Close(t *testing.T) {
 	}
 }
 
+func TestContext_Restart(t *testing.T) {
+	testCases := []struct {
+		name          string
+		ctx           context.Context
+		preCondition  func(t *testing.T, ctx *Context, startupContainer component.Container, events *[]string)
+		postCondition func(t *testing.T, ctx *Context, events []string)
+		wantErr       error
+	}{
+		{
+			name:    "nil context",
+			wantErr: errors.New("restart context: nil context"),
+		},
+		{
+			name: "non-refreshed context",
+			ctx:  context.Background(),
+			postCondition: func(t *testing.T, ctx *Context, events []string) {
+				assert.True(t, ctx.IsRunning())
+				assert.Equal(t, []string{
+					"anyListener *runtime.ContextRefreshedEvent",
+					"anyListener *runtime.ContextRestartedEvent",
+				}, events)
+			},
+		},
+		{
+			name: "refreshed context",
+			ctx:  context.Background(),
+			preCondition: func(t *testing.T, ctx *Context, startupContainer component.Container, events *[]string) {
+				err := ctx.Refresh(context.Background())
+				require.NoError(t, err)
+
+				err = ctx.Container().RegisterSingleton("anySingleton", &AnyComponent{})
+				require.NoError(t, err)
+				*events = (*events)[:0]
+			},
+			postCondition: func(t *testing.T, ctx *Context, events []string) {
+				assert.True(t, ctx.IsRunning())
+				assert.False(t, ctx.Container().ContainsSingleton("anySingleton"))
+				assert.Equal(t, []string{
+					"anyListener *runtime.ContextRestartingEvent",
+					"anyListener *runtime.ContextClosedEvent",
+					"anyListener *runtime.ContextRefreshedEvent",
+					"anyListener *runtime.ContextRestartedEvent",
+				}, events)
+			},
+		},
+		{
+			name: "closed context",
+			ctx:  context.Background(),
+			preCondition: func(t *testing.T, ctx *Context, startupContainer component.Container, events *[]string) {
+				err := ctx.Refresh(context.Background())
+				require.NoError(t, err)
+
+				err = ctx.Close(context.Background())
+				require.NoError(t, err)
+				*events = (*events)[:0]
+			},
+			postCondition: func(t *testing.T, ctx *Context, events []string) {
+				assert.True(t, ctx.IsRunning())
+				assert.NoError(t, ctx.Err())
+
+				select {
+				case <-ctx.Done():
+					t.Fatal("restarted context must not be done")
+				default:
+				}
+
+				assert.Equal(t, []string{
+					"anyListener *runtime.ContextRefreshedEvent",
+					"anyListener *runtime.ContextRestartedEvent",
+				}, events)
+			},
+		},
+		{
+			name: "restart prevented by listener",
+			ctx:  context.Background(),
+			preCondition: func(t *testing.T, ctx *Context, startupContainer component.Container, events *[]string) {
+				err := ctx.Refresh(context.Background())
+				require.NoError(t, err)
+
+				err = ctx.Container().RegisterSingleton("vetoListener", &anyEventListener{
+					name:   "vetoListener",
+					order:  component.HighestPrecedence,
+					events: events,
+					errFor: &runtime.ContextRestartingEvent{},
+				})
+				require.NoError(t, err)
+			},
+			postCondition: func(t *testing.T, ctx *Context, events []string) {
+				assert.True(t, ctx.IsRunning())
+				assert.True(t, ctx.Container().ContainsSingleton("vetoListener"))
+			},
+			wantErr: errors.New("restart context: publish event *runtime.ContextRestartingEvent: listener error"),
+		},
+		{
+			name: "environment provider error",
+			ctx:  context.Background(),
+			preCondition: func(t *testing.T, ctx *Context, startupContainer component.Container, events *[]string) {
+				ctx.envProvider = func() (runtime.Environment, error) {
+					return nil, errors.New("environment error")
+				}
+			},
+			wantErr: errors.New("restart context: prepare environment: environment error"),
+		},
+		{
+			name: "environment provider",
+			ctx:  context.Background(),
+			preCondition: func(t *testing.T, ctx *Context, startupContainer component.Container, events *[]string) {
+				err := ctx.Refresh(context.Background())
+				require.NoError(t, err)
+
+				ctx.envProvider = func() (runtime.Environment, error) {
+					env := NewEnvironment()
+					env.PropertySources().PushFront(config.NewMapPropertySource("restartProperties", map[string]any{
+						"restarted": true,
+					}))
+					return env, nil
+				}
+			},
+			postCondition: func(t *testing.T, ctx *Context, events []string) {
+				assert.True(t, ctx.Environment().PropertySources().Has("restartProperties"))
+
+				env, err := component.Resolve[runtime.Environment](context.Background(), ctx.Container(), envContainerKey)
+				require.NoError(t, err)
+				assert.Same(t, ctx.Environment(), env)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			events := make([]string, 0)
+			startupContainer := component.NewStandardContainer()
+			err := startupContainer.RegisterSingleton("anyListener", &anyEventListener{
+				name:   "anyListener",
+				events: &events,
+			})
+			require.NoError(t, err)
+
+			ctx := createContext(NewEnvironment(), startupContainer, io.NewDefaultResourceResolver())
+			if tc.preCondition != nil {
+				tc.preCondition(t, ctx, startupContainer, &events)
+			}
+
+			// when
+			err = ctx.Restart(tc.ctx)
+
+			// then
+			if tc.postCondition != nil {
+				tc.postCondition(t, ctx, events)
+			}
+
+			if tc.wantErr != nil {
+				assert.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestContext_PublishEvent(t *testing.T) {
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		event        func(ctx *Context) runtime.Event
+		refresh      bool
+		wantEvents   []string
+		wantErr      error
+		listenerErrs bool
+	}{
+		{
+			name: "nil context",
+			event: func(ctx *Context) runtime.Event {
+				return runtime.NewContextRefreshedEvent(ctx)
+			},
+			wantErr: errors.New("nil context"),
+		},
+		{
+			name: "nil event",
+			ctx:  context.Background(),
+			event: func(ctx *Context) runtime.Event {
+				return nil
+			},
+			wantErr: errors.New("nil event"),
+		},
+		{
+			name: "non-refreshed context",
+			ctx:  context.Background(),
+			event: func(ctx *Context) runtime.Event {
+				return runtime.NewContextRefreshedEvent(ctx)
+			},
+			wantErr: errors.New("context not refreshed"),
+		},
+		{
+			name:    "listeners in order",
+			ctx:     context.Background(),
+			refresh: true,
+			event: func(ctx *Context) runtime.Event {
+				return runtime.NewContextClosedEvent(ctx)
+			},
+			wantEvents: []string{
+				"firstListener *runtime.ContextClosedEvent",
+				"secondListener *runtime.ContextClosedEvent",
+			},
+		},
+		{
+			name:         "listener error",
+			ctx:          context.Background(),
+			refresh:      true,
+			listenerErrs: true,
+			event: func(ctx *Context) runtime.Event {
+				return runtime.NewContextClosedEvent(ctx)
+			},
+			wantEvents: []string{
+				"firstListener *runtime.ContextClosedEvent",
+			},
+			wantErr: errors.New("publish event *runtime.ContextClosedEvent: listener error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			events := make([]string, 0)
+			startupContainer := component.NewStandardContainer()
+
+			var errFor runtime.Event
+			if tc.listenerErrs {
+				errFor = &runtime.ContextClosedEvent{}
+			}
+
+			err := startupContainer.RegisterSingleton("secondListener", &anyEventListener{
+				name:   "secondListener",
+				order:  2,
+				events: &events,
+			})
+			require.NoError(t, err)
+
+			err = startupContainer.RegisterSingleton("firstListener", &anyEventListener{
+				name:   "firstListener",
+				order:  1,
+				events: &events,
+				errFor: errFor,
+			})
+			require.NoError(t, err)
+
+			ctx := createContext(NewEnvironment(), startupContainer, io.NewDefaultResourceResolver())
+			if tc.refresh {
+				require.NoError(t, ctx.Refresh(context.Background()))
+				events = events[:0]
+			}
+
+			// when
+			err = ctx.PublishEvent(tc.ctx, tc.event(ctx))
+
+			// then
+			if tc.wantErr != nil {
+				assert.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if tc.wantEvents != nil {
+				assert.Equal(t, tc.wantEvents, events)
+			}
+		})
+	}
+}
+
 func TestContext_Environment(t *testing.T) {
 	// given
 	env := NewEnvironment()
@@ -0,0 +1,184 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
+)
+
+const (
+	// DebugStartupProp is the property key for enabling the startup diagnostics.
+	DebugStartupProp = "procyon.debug.startup"
+)
+
+// PropertySourceDescriptor describes a property source of the environment. The properties are described
+// only for the sources knowing the line of each of their properties, such as the config files.
+type PropertySourceDescriptor struct {
+	Name       string
+	Origin     string
+	Properties []PropertyDescriptor
+}
+
+// PropertyDescriptor describes a property and its origin in the form of resource:line:column.
+type PropertyDescriptor struct {
+	Name   string
+	Origin string
+}
+
+// ProfilesDescriptor describes a set of profiles and the origin setting them, which is the origin of the
+// property setting them, "programmatic" or "built-in".
+type ProfilesDescriptor struct {
+	Profiles []string
+	Origin   string
+}
+
+// StartupDiagnostics describes how the environment has been assembled at startup. It is reported
+// when the procyon.debug.startup property is enabled.
+type StartupDiagnostics struct {
+	// PropertySources contains the property sources in the order of precedence.
+	PropertySources []PropertySourceDescriptor
+	// ActiveProfiles contains the active profiles.
+	ActiveProfiles ProfilesDescriptor
+	// DefaultProfiles contains the default profiles.
+	DefaultProfiles ProfilesDescriptor
+	// SkippedLocations contains the config file locations that have not been loaded.
+	SkippedLocations []string
+}
+
+// NewStartupDiagnostics creates the startup diagnostics of the given environment.
+func NewStartupDiagnostics(env runtime.Environment) *StartupDiagnostics {
+	if env == nil {
+		panic("nil environment")
+	}
+
+	diagnostics := &StartupDiagnostics{
+		PropertySources: make([]PropertySourceDescriptor, 0),
+		ActiveProfiles: ProfilesDescriptor{
+			Profiles: sortedProfiles(env.ActiveProfiles()),
+		},
+		DefaultProfiles: ProfilesDescriptor{
+			Profiles: sortedProfiles(env.DefaultProfiles()),
+		},
+		SkippedLocations: make([]string, 0),
+	}
+
+	for _, propSource := range env.PropertySources().Slice() {
+		diagnostics.PropertySources = append(diagnostics.PropertySources, PropertySourceDescriptor{
+			Name:       propSource.Name(),
+			Origin:     propSource.Origin(),
+			Properties: describeProperties(propSource),
+		})
+	}
+
+	if environment, ok := env.(*Environment); ok {
+		defaultOrigin, activeOrigin, skippedLocations := environment.configOrigins()
+		diagnostics.DefaultProfiles.Origin = defaultOrigin
+		diagnostics.ActiveProfiles.Origin = activeOrigin
+		diagnostics.SkippedLocations = append(diagnostics.SkippedLocations, skippedLocations...)
+	}
+
+	return diagnostics
+}
+
+// String returns the diagnostics in a human-readable form.
+func (d *StartupDiagnostics) String() string {
+	var sb strings.Builder
+
+	sb.WriteString("Property sources (in order of precedence):\n")
+	for index, propSource := range d.PropertySources {
+		fmt.Fprintf(&sb, "  %d. %s [%s]\n", index+1, propSource.Name, propSource.Origin)
+
+		for _, prop := range propSource.Properties {
+			fmt.Fprintf(&sb, "     - %s (from %s)\n", prop.Name, prop.Origin)
+		}
+	}
+
+	fmt.Fprintf(&sb, "Active profiles: %s\n", d.ActiveProfiles)
+	fmt.Fprintf(&sb, "Default profiles: %s\n", d.DefaultProfiles)
+
+	sb.WriteString("Skipped config locations:")
+	if len(d.SkippedLocations) == 0 {
+		sb.WriteString(" none")
+	}
+
+	for _, location := range d.SkippedLocations {
+		fmt.Fprintf(&sb, "\n  - %s", location)
+	}
+
+	return sb.String()
+}
+
+// String returns the profiles and their origin in a human-readable form.
+func (d ProfilesDescriptor) String() string {
+	profiles := "none"
+	if len(d.Profiles) != 0 {
+		profiles = strings.Join(d.Profiles, ", ")
+	}
+
+	if d.Origin == "" {
+		return profiles
+	}
+
+	return fmt.Sprintf("%s (from %s)", profiles, d.Origin)
+}
+
+// describeProperties returns the properties of the given source along with their origins, sorted by name.
+// It returns nil unless the source knows the line of its properties.
+func describeProperties(propSource config.PropertySource) []PropertyDescriptor {
+	lookup, ok := propSource.(config.OriginLookup)
+	if !ok {
+		return nil
+	}
+
+	names := propSource.PropertyNames()
+	slices.Sort(names)
+
+	var props []PropertyDescriptor
+	for _, name := range names {
+		origin, exists := lookup.PropertyOrigin(name)
+		if !exists || origin.Line == 0 {
+			continue
+		}
+
+		props = append(props, PropertyDescriptor{Name: name, Origin: origin.String()})
+	}
+
+	return props
+}
+
+// isStartupDebugEnabled reports whether the startup diagnostics are enabled in the given environment.
+func isStartupDebugEnabled(env runtime.Environment) (bool, error) {
+	value := lookupString(env, DebugStartupProp, "false")
+
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s '%s'", DebugStartupProp, value)
+	}
+
+	return enabled, nil
+}
+
+// sortedProfiles returns the given profiles in sorted order.
+func sortedProfiles(profiles []string) []string {
+	sorted := slices.Clone(profiles)
+	slices.Sort(sorted)
+	return sorted
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"context"
+	"testing"
+
+	"codnect.io/procyon/runtime/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStartupDiagnostics(t *testing.T) {
+	// given
+	env := NewEnvironment()
+	env.PropertySources().PushBack(config.NewMapPropertySource("first", map[string]any{}))
+	env.PropertySources().PushBack(config.NewMapPropertySource("second", map[string]any{}))
+	require.NoError(t, env.SetActiveProfiles("prod", "eu"))
+	env.setConfigOrigins("built-in", "programmatic", []string{"resources/procyon-eu.yaml"})
+
+	// when
+	diagnostics := NewStartupDiagnostics(env)
+
+	// then
+	assert.Equal(t, &StartupDiagnostics{
+		PropertySources: []PropertySourceDescriptor{
+			{Name: "first", Origin: "first"},
+			{Name: "second", Origin: "second"},
+		},
+		ActiveProfiles:   ProfilesDescriptor{Profiles: []string{"eu", "prod"}, Origin: "programmatic"},
+		DefaultProfiles:  ProfilesDescriptor{Profiles: []string{"default"}, Origin: "built-in"},
+		SkippedLocations: []string{"resources/procyon-eu.yaml"},
+	}, diagnostics)
+}
+
+func TestNewStartupDiagnostics_PropertyOrigins(t *testing.T) {
+	// given
+	resource := &AnyMockResource{}
+	resource.On("Reader").Return(&FakeFile{contents: "app:\n  name: demo\n  ports:\n    - 8080\n"}, nil)
+	resource.On("Location").Return("resources/procyon.yaml")
+
+	propSource, err := config.NewYamlPropertySourceLoader().Load(context.Background(), "config", resource)
+	require.NoError(t, err)
+
+	env := NewEnvironment()
+	env.PropertySources().PushBack(propSource)
+	env.PropertySources().PushBack(config.NewMapPropertySource("anyMap", map[string]any{"app.name": "any"}))
+
+	// when
+	diagnostics := NewStartupDiagnostics(env)
+
+	// then
+	assert.Equal(t, []PropertySourceDescriptor{
+		{
+			Name:   "config",
+			Origin: propSource.Origin(),
+			Properties: []PropertyDescriptor{
+				{Name: "app.name", Origin: "resources/procyon.yaml:2:3"},
+				{Name: "app.ports[0]", Origin: "resources/procyon.yaml:4:7"},
+			},
+		},
+		{Name: "anyMap", Origin: "anyMap"},
+	}, diagnostics.PropertySources)
+}
+
+func TestNewStartupDiagnostics_NilEnvironment(t *testing.T) {
+	assert.PanicsWithValue(t, "nil environment", func() {
+		NewStartupDiagnostics(nil)
+	})
+}
+
+func TestStartupDiagnostics_String(t *testing.T) {
+	testCases := []struct {
+		name        string
+		diagnostics *StartupDiagnostics
+		want        string
+	}{
+		{
+			name:        "empty",
+			diagnostics: &StartupDiagnostics{},
+			want: "Property sources (in order of precedence):\n" +
+				"Active profiles: none\n" +
+				"Default profiles: none\n" +
+				"Skipped config locations: none",
+		},
+		{
+			name: "full",
+			diagnostics: &StartupDiagnostics{
+				PropertySources: []PropertySourceDescriptor{
+					{Name: "commandLineArgs", Origin: "commandLineArgs"},
+					{Name: "config", Origin: "resources/procyon.yaml", Properties: []PropertyDescriptor{
+						{Name: "app.name", Origin: "resources/procyon.yaml:2:3"},
+					}},
+				},
+				ActiveProfiles:   ProfilesDescriptor{Profiles: []string{"dev", "eu"}, Origin: "commandLineArgs"},
+				DefaultProfiles:  ProfilesDescriptor{Profiles: []string{"default"}, Origin: "built-in"},
+				SkippedLocations: []string{"resources/procyon.yml", "resources/procyon-dev.yml"},
+			},
+			want: "Property sources (in order of precedence):\n" +
+				"  1. commandLineArgs [commandLineArgs]\n" +
+				"  2. config [resources/procyon.yaml]\n" +
+				"     - app.name (from resources/procyon.yaml:2:3)\n" +
+				"Active profiles: dev, eu (from commandLineArgs)\n" +
+				"Default profiles: default (from built-in)\n" +
+				"Skipped config locations:\n" +
+				"  - resources/procyon.yml\n" +
+				"  - resources/procyon-dev.yml",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			result := tc.diagnostics.String()
+
+			// then
+			assert.Equal(t, tc.want, result)
+		})
+	}
+}
@@ -20,10 +20,12 @@ import (
 	"fmt"
 	"maps"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 
 	"codnect.io/procyon/component"
+	"codnect.io/procyon/io"
 	"codnect.io/procyon/runtime"
 	"codnect.io/procyon/runtime/config"
 )
@@ -36,6 +38,24 @@ const (
 
 	// DefaultConfigLocation is the default location for configuration files.
 	DefaultConfigLocation = "resources/"
+	// ConfigLocationProp is the property key for specifying the locations of the configuration files
+	// in place of the default location.
+	ConfigLocationProp = "procyon.config.location"
+	// AdditionalConfigLocationProp is the property key for specifying the locations of the configuration
+	// files in addition to the default location.
+	AdditionalConfigLocationProp = "procyon.config.additional-location"
+
+	// DotenvFile is the .env file in the working directory, which is loaded unless procyon.config.dotenv.enabled
+	// is false. Its variables take precedence over the configuration files, including the procyon.env files in
+	// the configuration locations, but not over the environment variables.
+	DotenvFile = ".env"
+	// DotenvEnabledProp is the property key for enabling the loading of the .env file in the working directory.
+	DotenvEnabledProp = "procyon.config.dotenv.enabled"
+
+	// programmaticProfilesOrigin is the origin of the profiles set programmatically.
+	programmaticProfilesOrigin = "programmatic"
+	// builtinProfilesOrigin is the origin of the reserved default profile.
+	builtinProfilesOrigin = "built-in"
 )
 
 var (
@@ -51,6 +71,11 @@ type Environment struct {
 	propertySources  *config.PropertySources
 	propertyResolver config.PropertyResolver
 
+	// the origins of the profiles and the skipped config locations are recorded for the startup diagnostics
+	activeProfilesOrigin  string
+	defaultProfilesOrigin string
+	skippedLocations      []string
+
 	mu sync.RWMutex
 }
 
@@ -185,6 +210,24 @@ func (e *Environment) SetDefaultProfiles(profiles ...string) error {
 	return e.setDefaultProfiles(profiles...)
 }
 
+// setConfigOrigins records the origins of the resolved profiles and the skipped config locations.
+func (e *Environment) setConfigOrigins(defaultProfilesOrigin, activeProfilesOrigin string, skippedLocations []string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.defaultProfilesOrigin = defaultProfilesOrigin
+	e.activeProfilesOrigin = activeProfilesOrigin
+	e.skippedLocations = slices.Clone(skippedLocations)
+}
+
+// configOrigins returns the origins of the default and active profiles and the skipped config locations.
+func (e *Environment) configOrigins() (defaultProfilesOrigin, activeProfilesOrigin string, skippedLocations []string) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.defaultProfilesOrigin, e.activeProfilesOrigin, slices.Clone(e.skippedLocations)
+}
+
 // PropertySources returns the property sources.
 func (e *Environment) PropertySources() *config.PropertySources {
 	return e.propertySources
@@ -284,6 +327,11 @@ func (c *configEnvCustomizer) CustomizeEnvironment(env runtime.Environment, app
 }
 
 func (c *configEnvCustomizer) customizeEnvironment(env runtime.Environment, app runtime.Application) error {
+	// the .env file is loaded first, as it may set the config locations and the profiles
+	if err := c.addDotenv(env); err != nil {
+		return err
+	}
+
 	loaders, err := c.loadPropSourceLoaders()
 	if err != nil {
 		return err
@@ -292,25 +340,34 @@ func (c *configEnvCustomizer) customizeEnvironment(env runtime.Environment, app
 	dataLoader := config.NewStandardDataLoader(app.ResourceResolver(), loaders...)
 	propSources := config.NewPropertySources()
 	resPropResolver := config.NewDefaultPropertyResolver(propSources)
+	location := c.configLocation(env)
 
-	// Load base configuration without any profile filtering.
-	err = c.loadConfig(propSources, dataLoader)
+	// Load base configuration without any profile filtering. The documents with an activation are
+	// deferred until the profiles are resolved.
+	baseData, err := c.loadConfig(dataLoader, location)
 	if err != nil {
 		return err
 	}
 
-	var (
-		defaultProfiles []string
-		activeProfiles  []string
-	)
+	skipped := dataLoader.SkippedLocations()
+
+	deferred := make([]config.Data, 0)
+	for _, data := range baseData {
+		if data.Activation() != nil {
+			deferred = append(deferred, data)
+			continue
+		}
+
+		propSources.PushFront(data.PropertySource())
+	}
 
 	// Resolve which profiles should be active based on environment and base config.
-	defaultProfiles, err = c.getDefaultProfiles(env, resPropResolver)
+	defaultProfiles, defaultOrigin, err := c.getDefaultProfiles(env, resPropResolver, propSources)
 	if err != nil {
 		return err
 	}
 
-	activeProfiles, err = c.getActiveProfiles(env, resPropResolver)
+	activeProfiles, activeOrigin, err := c.getActiveProfiles(env, resPropResolver, propSources)
 	if err != nil {
 		return err
 	}
@@ -322,13 +379,130 @@ func (c *configEnvCustomizer) customizeEnvironment(env runtime.Environment, app
 		profiles = append(profiles, defaultProfiles...)
 	}
 
-	// Load profile-specific configuration files on top of the base config.
-	err = c.loadConfig(propSources, dataLoader, profiles...)
+	// Add the activated documents of the base config, then load profile-specific configuration files
+	// on top of them.
+	err = c.addActivatedConfig(env, propSources, deferred, profiles, false)
+	if err != nil {
+		return err
+	}
+
+	// the data loader maps the default profile to the base config, which has been loaded already
+	specificProfiles := slices.DeleteFunc(slices.Clone(profiles), func(profile string) bool {
+		return profile == config.DefaultProfile
+	})
+
+	if len(specificProfiles) != 0 {
+		profileData, loadErr := c.loadConfig(dataLoader, location, specificProfiles...)
+		if loadErr != nil {
+			return loadErr
+		}
+
+		skipped = append(skipped, dataLoader.SkippedLocations()...)
+
+		err = c.addActivatedConfig(env, propSources, profileData, profiles, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = c.applyToEnvironment(env, defaultProfiles, activeProfiles, propSources)
 	if err != nil {
 		return err
 	}
 
-	return c.applyToEnvironment(env, defaultProfiles, activeProfiles, propSources)
+	if environment, ok := env.(*Environment); ok {
+		environment.setConfigOrigins(defaultOrigin, activeOrigin, skipped)
+	}
+
+	return nil
+}
+
+// addDotenv loads the .env file in the working directory if it exists, unless it is disabled by the
+// procyon.config.dotenv.enabled property. Its variables are added right after the environment variables,
+// or after the property sources of the environment if there is no environment variable source.
+func (c *configEnvCustomizer) addDotenv(env runtime.Environment) error {
+	value := lookupString(env, DotenvEnabledProp, "true")
+
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("invalid %s '%s'", DotenvEnabledProp, value)
+	}
+
+	resource := io.NewFileResource("./" + DotenvFile)
+	if !enabled || !resource.Exists() {
+		return nil
+	}
+
+	propSource, err := config.NewDotenvPropertySourceLoader().Load(context.Background(), DotenvFile, resource)
+	if err != nil {
+		return err
+	}
+
+	propSources := env.PropertySources()
+	for index, existing := range propSources.Slice() {
+		if _, ok := existing.(*runtime.EnvPropertySource); ok {
+			propSources.Insert(index+1, propSource)
+			return nil
+		}
+	}
+
+	propSources.PushBack(propSource)
+	return nil
+}
+
+// loadProfileConfig loads the configuration of the given profiles that the environment does not hold yet,
+// such as the profile-specific configuration files of the profiles a child context activates on top of
+// the profiles of its parent. The documents of the base configuration activated on the profiles are
+// loaded as well. It returns the property sources in the order of their precedence.
+func (c *configEnvCustomizer) loadProfileConfig(env runtime.Environment, resourceResolver io.ResourceResolver,
+	profiles []string) ([]config.PropertySource, error) {
+	loaders, err := c.loadPropSourceLoaders()
+	if err != nil {
+		return nil, err
+	}
+
+	dataLoader := config.NewStandardDataLoader(resourceResolver, loaders...)
+	propSources := config.NewPropertySources()
+	location := c.configLocation(env)
+
+	baseData, err := c.loadConfig(dataLoader, location)
+	if err != nil {
+		return nil, err
+	}
+
+	deferred := slices.DeleteFunc(baseData, func(data config.Data) bool {
+		return data.Activation() == nil
+	})
+
+	err = c.addActivatedConfig(env, propSources, deferred, profiles, false)
+	if err != nil {
+		return nil, err
+	}
+
+	specificProfiles := slices.DeleteFunc(slices.Clone(profiles), func(profile string) bool {
+		return profile == config.DefaultProfile
+	})
+
+	if len(specificProfiles) != 0 {
+		profileData, loadErr := c.loadConfig(dataLoader, location, specificProfiles...)
+		if loadErr != nil {
+			return nil, loadErr
+		}
+
+		err = c.addActivatedConfig(env, propSources, profileData, profiles, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	loaded := make([]config.PropertySource, 0)
+	for _, propSource := range propSources.Slice() {
+		if !env.PropertySources().Has(propSource.Name()) {
+			loaded = append(loaded, propSource)
+		}
+	}
+
+	return loaded, nil
 }
 
 // loadPropSourceLoaders loads all registered PropertySourceLoader components and returns them as a slice.
@@ -348,64 +522,101 @@ func (c *configEnvCustomizer) loadPropSourceLoaders() ([]config.PropertySourceLo
 }
 
 // getDefaultProfiles determines the default profiles to be used based on the environment and the loaded configuration.
-func (c *configEnvCustomizer) getDefaultProfiles(env runtime.Environment, resPropResolver config.PropertyResolver) ([]string, error) {
+// It also returns the origin of the profiles, which is the name of the property source setting them.
+func (c *configEnvCustomizer) getDefaultProfiles(env runtime.Environment, resPropResolver config.PropertyResolver,
+	propSources *config.PropertySources) ([]string, string, error) {
 	profiles, err := lookupProfilesProp(env.PropertyResolver(), DefaultProfilesProp)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	} else if len(profiles) > 0 {
-		return profiles, nil
+		return profiles, propertyOrigin(env.PropertySources(), DefaultProfilesProp), nil
 	}
 
 	profiles = env.DefaultProfiles()
 	if len(profiles) != 0 && !slices.Equal(profiles, []string{"default"}) {
-		return profiles, nil
+		return profiles, programmaticProfilesOrigin, nil
 	}
 
 	profiles, err = lookupProfilesProp(resPropResolver, DefaultProfilesProp)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	} else if len(profiles) > 0 {
-		return profiles, nil
+		return profiles, propertyOrigin(propSources, DefaultProfilesProp), nil
 	}
 
-	return []string{"default"}, nil
+	return []string{"default"}, builtinProfilesOrigin, nil
 }
 
 // getActiveProfiles determines the active profiles to be used based on the environment and the loaded configuration.
-func (c *configEnvCustomizer) getActiveProfiles(env runtime.Environment, resPropResolver config.PropertyResolver) ([]string, error) {
+// It also returns the origin of the profiles, which is the name of the property source setting them.
+func (c *configEnvCustomizer) getActiveProfiles(env runtime.Environment, resPropResolver config.PropertyResolver,
+	propSources *config.PropertySources) ([]string, string, error) {
 	profiles, err := lookupProfilesProp(env.PropertyResolver(), ActiveProfilesProp)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	} else if len(profiles) > 0 {
-		return profiles, nil
+		return profiles, propertyOrigin(env.PropertySources(), ActiveProfilesProp), nil
 	}
 
 	profiles = env.ActiveProfiles()
 	if len(profiles) != 0 {
-		return profiles, nil
+		return profiles, programmaticProfilesOrigin, nil
 	}
 
 	profiles, err = lookupProfilesProp(resPropResolver, ActiveProfilesProp)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	} else if len(profiles) > 0 {
-		return profiles, nil
+		return profiles, propertyOrigin(propSources, ActiveProfilesProp), nil
 	}
 
-	return []string{}, nil
+	return []string{}, "", nil
 }
 
-// loadConfig loads configuration data from the specified location and profiles, and
-// adds the property sources to the environment.
-func (c *configEnvCustomizer) loadConfig(propSources *config.PropertySources, dataLoader config.DataLoader, profiles ...string) error {
-	cfgData, err := dataLoader.Load(context.Background(), DefaultConfigLocation, profiles...)
+// configLocation returns the locations of the configuration files separated by semicolons. They are given by
+// the procyon.config.location and procyon.config.additional-location properties of the environment, which
+// accept comma-separated locations. The default location is used unless procyon.config.location is set, and
+// it is optional, whereas the configured locations must exist unless they are prefixed with optional:.
+func (c *configEnvCustomizer) configLocation(env runtime.Environment) string {
+	locations := splitLocations(env.PropertyResolver(), ConfigLocationProp)
+	if len(locations) == 0 {
+		locations = append(locations, "optional:"+DefaultConfigLocation)
+	}
+
+	locations = append(locations, splitLocations(env.PropertyResolver(), AdditionalConfigLocationProp)...)
+	return strings.Join(locations, ";")
+}
+
+// loadConfig loads configuration data from the given location for the specified profiles.
+func (c *configEnvCustomizer) loadConfig(dataLoader config.DataLoader, location string, profiles ...string) ([]config.Data, error) {
+	cfgData, err := dataLoader.Load(context.Background(), location, profiles...)
 	if err != nil {
-		return fmt.Errorf("config location %s: %w", DefaultConfigLocation, err)
+		return nil, fmt.Errorf("config location %s: %w", location, err)
 	}
 
+	return cfgData, nil
+}
+
+// addActivatedConfig adds the property sources of the given configuration data that are active for the given
+// profiles. The property conditions of the activations are evaluated against the environment and the
+// configuration loaded so far. As the profiles are already resolved, the profile-specific data and the
+// documents activated on profiles cannot set them.
+func (c *configEnvCustomizer) addActivatedConfig(env runtime.Environment, propSources *config.PropertySources, cfgData []config.Data,
+	profiles []string, profileSpecific bool) error {
+	resolver := config.NewDefaultPropertyResolver(config.NewPropertySources(
+		append(env.PropertySources().Slice(), propSources.Slice()...)...,
+	))
+
 	for _, data := range cfgData {
-		if err = checkProfileSpecificProps(data); err != nil && len(profiles) != 0 {
-			return fmt.Errorf("config file %s: %w", data.PropertySource().Origin(), err)
+		activation := data.Activation()
+		if activation != nil && !activation.IsActive(profiles, resolver) {
+			continue
+		}
+
+		if profileSpecific || activation != nil && activation.IsProfileSpecific() {
+			if err := checkProfileSpecificProps(data); err != nil {
+				return fmt.Errorf("config file %s: %w", data.PropertySource().Origin(), err)
+			}
 		}
 
 		propSources.PushFront(data.PropertySource())
@@ -452,6 +663,18 @@ func checkProfileSpecificProps(data config.Data) error {
 	return nil
 }
 
+// propertyOrigin returns the origin of the given property in the first property source containing it,
+// see config.OriginOf.
+func propertyOrigin(propSources *config.PropertySources, key string) string {
+	for _, propSource := range propSources.Slice() {
+		if _, ok := propSource.Value(key); ok {
+			return config.OriginOf(propSource, key)
+		}
+	}
+
+	return ""
+}
+
 // lookupProfilesProp looks up a profile property and returns its parsed profile names.
 func lookupProfilesProp(resolver config.PropertyResolver, key string) ([]string, error) {
 	val, ok := resolver.Lookup(key)
@@ -467,6 +690,24 @@ func lookupProfilesProp(resolver config.PropertyResolver, key string) ([]string,
 	return splitProfiles(s)
 }
 
+// splitLocations returns the comma or semicolon-separated locations given by the property with the given key.
+func splitLocations(resolver config.PropertyResolver, key string) []string {
+	locations := make([]string, 0)
+
+	val, ok := resolver.Lookup(key)
+	if !ok {
+		return locations
+	}
+
+	for _, location := range strings.FieldsFunc(fmt.Sprint(val), func(r rune) bool { return r == ',' || r == ';' }) {
+		if location = strings.TrimSpace(location); location != "" {
+			locations = append(locations, location)
+		}
+	}
+
+	return locations
+}
+
 // splitProfiles splits a comma-separated profile string into validated profile names.
 func splitProfiles(s string) ([]string, error) {
 	parts := strings.Split(s, ",")
@@ -18,6 +18,11 @@ import (
 	"errors"
 	stdio "io"
 	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"slices"
 	"testing"
 
 	"codnect.io/procyon/component"
@@ -502,7 +507,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				mockApp := app.(*AnyMockApplication)
 				mockApp.On("ResourceResolver").Return(resourceResolver)
 			},
-			wantErr: errors.New("customize environment: config location resources/: load config for default profile: resolve config file error"),
+			wantErr: errors.New("customize environment: config location optional:resources/: load config for default profile: resolve config file error"),
 		},
 		{
 			name: "procyon.profiles.default not allowed in custom profile config",
@@ -526,6 +531,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				customPropResource := &AnyMockResource{}
 				customPropResource.On("Exists").Return(true)
 				customPropResource.On("Reader").Return(customPropFile, nil)
+				customPropResource.On("Location").Return("resources/procyon-custom.yaml")
 
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon-custom.yaml").
 					Return(customPropResource, nil)
@@ -556,6 +562,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				defaultPropResource := &AnyMockResource{}
 				defaultPropResource.On("Exists").Return(true)
 				defaultPropResource.On("Reader").Return(defaultPropFile, nil)
+				defaultPropResource.On("Location").Return("resources/procyon.yaml")
 
 				propResourceResolver := &AnyMockResourceResolver{}
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon.yaml").
@@ -584,6 +591,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				defaultPropResource := &AnyMockResource{}
 				defaultPropResource.On("Exists").Return(true)
 				defaultPropResource.On("Reader").Return(defaultPropFile, nil)
+				defaultPropResource.On("Location").Return("resources/procyon.yaml")
 
 				propResourceResolver := &AnyMockResourceResolver{}
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon.yaml").
@@ -617,6 +625,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				defaultPropResource := &AnyMockResource{}
 				defaultPropResource.On("Exists").Return(true)
 				defaultPropResource.On("Reader").Return(defaultPropFile, nil)
+				defaultPropResource.On("Location").Return("resources/procyon.yaml")
 
 				propResourceResolver := &AnyMockResourceResolver{}
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon.yaml").
@@ -645,6 +654,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				defaultPropResource := &AnyMockResource{}
 				defaultPropResource.On("Exists").Return(true)
 				defaultPropResource.On("Reader").Return(defaultPropFile, nil)
+				defaultPropResource.On("Location").Return("resources/procyon.yaml")
 
 				propResourceResolver := &AnyMockResourceResolver{}
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon.yaml").
@@ -673,6 +683,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				defaultPropResource := &AnyMockResource{}
 				defaultPropResource.On("Exists").Return(true)
 				defaultPropResource.On("Reader").Return(defaultPropFile, nil)
+				defaultPropResource.On("Location").Return("resources/procyon.yaml")
 
 				propResourceResolver := &AnyMockResourceResolver{}
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon.yaml").
@@ -701,6 +712,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				defaultPropResource := &AnyMockResource{}
 				defaultPropResource.On("Exists").Return(true)
 				defaultPropResource.On("Reader").Return(defaultPropFile, nil)
+				defaultPropResource.On("Location").Return("resources/procyon.yaml")
 
 				propResourceResolver := &AnyMockResourceResolver{}
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon.yaml").
@@ -740,6 +752,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				customPropResource := &AnyMockResource{}
 				customPropResource.On("Exists").Return(true)
 				customPropResource.On("Reader").Return(customPropFile, nil)
+				customPropResource.On("Location").Return("resources/procyon-custom.yaml")
 
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon-custom.yaml").
 					Return(customPropResource, nil)
@@ -770,6 +783,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				defaultPropResource := &AnyMockResource{}
 				defaultPropResource.On("Exists").Return(true)
 				defaultPropResource.On("Reader").Return(defaultPropFile, nil)
+				defaultPropResource.On("Location").Return("resources/procyon.yaml")
 
 				propResourceResolver := &AnyMockResourceResolver{}
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon.yaml").
@@ -785,6 +799,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				customPropResource := &AnyMockResource{}
 				customPropResource.On("Exists").Return(true)
 				customPropResource.On("Reader").Return(customPropFile, nil)
+				customPropResource.On("Location").Return("resources/procyon-custom.yaml")
 
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon-custom.yaml").
 					Return(customPropResource, nil)
@@ -818,6 +833,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				defaultPropResource := &AnyMockResource{}
 				defaultPropResource.On("Exists").Return(true)
 				defaultPropResource.On("Reader").Return(defaultPropFile, nil)
+				defaultPropResource.On("Location").Return("resources/procyon.yaml")
 
 				propResourceResolver := &AnyMockResourceResolver{}
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon.yaml").
@@ -833,6 +849,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				customPropResource := &AnyMockResource{}
 				customPropResource.On("Exists").Return(true)
 				customPropResource.On("Reader").Return(customPropFile, nil)
+				customPropResource.On("Location").Return("resources/procyon-custom.yaml")
 
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon-custom.yaml").
 					Return(customPropResource, nil)
@@ -868,6 +885,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				defaultPropResource := &AnyMockResource{}
 				defaultPropResource.On("Exists").Return(true)
 				defaultPropResource.On("Reader").Return(defaultPropFile, nil)
+				defaultPropResource.On("Location").Return("resources/procyon.yaml")
 
 				propResourceResolver := &AnyMockResourceResolver{}
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon.yaml").
@@ -883,6 +901,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				customPropResource := &AnyMockResource{}
 				customPropResource.On("Exists").Return(true)
 				customPropResource.On("Reader").Return(customPropFile, nil)
+				customPropResource.On("Location").Return("resources/procyon-custom.yaml")
 
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon-custom.yaml").
 					Return(customPropResource, nil)
@@ -913,6 +932,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				defaultPropResource := &AnyMockResource{}
 				defaultPropResource.On("Exists").Return(true)
 				defaultPropResource.On("Reader").Return(defaultPropFile, nil)
+				defaultPropResource.On("Location").Return("resources/procyon.yaml")
 
 				propResourceResolver := &AnyMockResourceResolver{}
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon.yaml").
@@ -928,6 +948,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				customPropResource := &AnyMockResource{}
 				customPropResource.On("Exists").Return(true)
 				customPropResource.On("Reader").Return(customPropFile, nil)
+				customPropResource.On("Location").Return("resources/procyon-custom.yaml")
 
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon-custom.yaml").
 					Return(customPropResource, nil)
@@ -961,6 +982,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				defaultPropResource := &AnyMockResource{}
 				defaultPropResource.On("Exists").Return(true)
 				defaultPropResource.On("Reader").Return(defaultPropFile, nil)
+				defaultPropResource.On("Location").Return("resources/procyon.yaml")
 
 				propResourceResolver := &AnyMockResourceResolver{}
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon.yaml").
@@ -976,6 +998,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				customPropResource := &AnyMockResource{}
 				customPropResource.On("Exists").Return(true)
 				customPropResource.On("Reader").Return(customPropFile, nil)
+				customPropResource.On("Location").Return("resources/procyon-custom.yaml")
 
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon-custom.yaml").
 					Return(customPropResource, nil)
@@ -1011,6 +1034,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				defaultPropResource := &AnyMockResource{}
 				defaultPropResource.On("Exists").Return(true)
 				defaultPropResource.On("Reader").Return(defaultPropFile, nil)
+				defaultPropResource.On("Location").Return("resources/procyon.yaml")
 
 				propResourceResolver := &AnyMockResourceResolver{}
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon.yaml").
@@ -1026,6 +1050,7 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				customPropResource := &AnyMockResource{}
 				customPropResource.On("Exists").Return(true)
 				customPropResource.On("Reader").Return(customPropFile, nil)
+				customPropResource.On("Location").Return("resources/procyon-custom.yaml")
 
 				propResourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon-custom.yaml").
 					Return(customPropResource, nil)
@@ -1040,6 +1065,178 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 				"app.version": "1.0.0",
 			},
 		},
+		{
+			name: "multi-document: activated documents override base document",
+			env:  NewEnvironment(),
+			app:  &AnyMockApplication{},
+			preCondition: func(customizer *configEnvCustomizer, env runtime.Environment, app runtime.Application) {
+				mockApp := app.(*AnyMockApplication)
+				mockApp.On("ResourceResolver").Return(newYamlResourceResolver(map[string]string{
+					"resources/procyon.yaml": "procyon.profiles.active: prod\napp:\n  name: demo\n  region: any\n" +
+						"---\nprocyon.config.activate.on-profile: prod & !eu\napp.name: demo-prod\n" +
+						"---\nprocyon.config.activate.on-profile: eu\napp.region: eu\n",
+				}))
+			},
+			wantProperties: map[string]any{
+				"app.name":   "demo-prod",
+				"app.region": "any",
+			},
+		},
+		{
+			name: "multi-document: profile-specific config overrides activated document",
+			env:  NewEnvironment(),
+			app:  &AnyMockApplication{},
+			preCondition: func(customizer *configEnvCustomizer, env runtime.Environment, app runtime.Application) {
+				mockApp := app.(*AnyMockApplication)
+				mockApp.On("ResourceResolver").Return(newYamlResourceResolver(map[string]string{
+					"resources/procyon.yaml": "procyon.profiles.active: prod\napp:\n  name: demo\n  version: 1.0.0\n" +
+						"---\nprocyon.config.activate.on-profile: prod\napp:\n  name: demo-prod\n  version: 2.0.0\n",
+					"resources/procyon-prod.yaml": "app.name: demo-prod-file\n" +
+						"---\nprocyon.config.activate.on-profile: eu\napp.version: 3.0.0\n",
+				}))
+			},
+			wantProperties: map[string]any{
+				"app.name":    "demo-prod-file",
+				"app.version": "2.0.0",
+			},
+		},
+		{
+			name: "multi-document: activated on property",
+			env:  NewEnvironment(),
+			app:  &AnyMockApplication{},
+			preCondition: func(customizer *configEnvCustomizer, env runtime.Environment, app runtime.Application) {
+				env.PropertySources().PushBack(config.NewMapPropertySource("anyEnv", map[string]any{"feature.enabled": "true"}))
+
+				mockApp := app.(*AnyMockApplication)
+				mockApp.On("ResourceResolver").Return(newYamlResourceResolver(map[string]string{
+					"resources/procyon.yaml": "app.name: demo\napp.region: any\n" +
+						"---\nprocyon.config.activate.on-property: feature.enabled\napp.name: demo-feature\n" +
+						"---\nprocyon.config.activate.on-property: app.region=eu\napp.region: eu\n",
+				}))
+			},
+			wantProperties: map[string]any{
+				"app.name":   "demo-feature",
+				"app.region": "any",
+			},
+		},
+		{
+			name: "multi-document: procyon.profiles.active not allowed in profile activated document",
+			env:  NewEnvironment(),
+			app:  &AnyMockApplication{},
+			preCondition: func(customizer *configEnvCustomizer, env runtime.Environment, app runtime.Application) {
+				mockApp := app.(*AnyMockApplication)
+				mockApp.On("ResourceResolver").Return(newYamlResourceResolver(map[string]string{
+					"resources/procyon.yaml": "app.name: demo\n" +
+						"---\nprocyon.config.activate.on-profile: default\nprocyon.profiles.active: other\n",
+				}))
+			},
+			wantErr: errors.New("customize environment: config file resources/procyon.yaml (document #1): procyon.profiles.active not allowed in profile-specific config"),
+		},
+		{
+			name: "config location from environment variable",
+			env:  NewEnvironment(),
+			app:  &AnyMockApplication{},
+			preCondition: func(customizer *configEnvCustomizer, env runtime.Environment, app runtime.Application) {
+				env.PropertySources().PushBack(config.NewMapPropertySource("anyEnv", map[string]any{
+					"PROCYON_CONFIG_LOCATION": "config/",
+				}))
+
+				mockApp := app.(*AnyMockApplication)
+				mockApp.On("ResourceResolver").Return(newYamlResourceResolver(map[string]string{
+					"resources/procyon.yaml": "app:\n  name: demo\n  version: 1.0.0",
+					"config/procyon.yaml":    "app:\n  name: demo-config",
+				}))
+			},
+			wantProperties: map[string]any{
+				"app.name": "demo-config",
+			},
+		},
+		{
+			name: "additional config location overrides default location",
+			env:  NewEnvironment(),
+			app:  &AnyMockApplication{},
+			preCondition: func(customizer *configEnvCustomizer, env runtime.Environment, app runtime.Application) {
+				env.PropertySources().PushBack(config.NewMapPropertySource("anyArgs", map[string]any{
+					"procyon.config.additional-location": "optional:extra/app.yaml, optional:missing/",
+				}))
+
+				mockApp := app.(*AnyMockApplication)
+				mockApp.On("ResourceResolver").Return(newYamlResourceResolver(map[string]string{
+					"resources/procyon.yaml": "app:\n  name: demo\n  version: 1.0.0",
+					"extra/app.yaml":         "app:\n  name: demo-extra",
+				}))
+			},
+			wantProperties: map[string]any{
+				"app.name":    "demo-extra",
+				"app.version": "1.0.0",
+			},
+		},
+		{
+			name: "config import with profile-specific variant",
+			env:  NewEnvironment(),
+			app:  &AnyMockApplication{},
+			preCondition: func(customizer *configEnvCustomizer, env runtime.Environment, app runtime.Application) {
+				mockApp := app.(*AnyMockApplication)
+				mockApp.On("ResourceResolver").Return(newYamlResourceResolver(map[string]string{
+					"resources/procyon.yaml":     "procyon.profiles.active: dev\nprocyon.config.import: db.yaml\napp.name: demo\n",
+					"resources/db.yaml":          "db:\n  url: localhost\n  pool: 5",
+					"resources/db-dev.yaml":      "db.url: dev-host\n",
+					"resources/procyon-dev.yaml": "app.name: demo-dev\n",
+				}))
+			},
+			wantProperties: map[string]any{
+				"app.name": "demo-dev",
+				"db.url":   "dev-host",
+				"db.pool":  5,
+			},
+		},
+		{
+			name: "configured config location does not exist",
+			env:  NewEnvironment(),
+			app:  &AnyMockApplication{},
+			preCondition: func(customizer *configEnvCustomizer, env runtime.Environment, app runtime.Application) {
+				env.PropertySources().PushBack(config.NewMapPropertySource("anyArgs", map[string]any{
+					"procyon.config.location": "config/",
+				}))
+
+				mockApp := app.(*AnyMockApplication)
+				mockApp.On("ResourceResolver").Return(newYamlResourceResolver(map[string]string{
+					"resources/procyon.yaml": "app.name: demo\n",
+				}))
+			},
+			wantErr: errors.New("customize environment: config location config/: load config for default profile: " +
+				"location config/: config directory not found"),
+		},
+		{
+			name: "configured config file does not exist",
+			env:  NewEnvironment(),
+			app:  &AnyMockApplication{},
+			preCondition: func(customizer *configEnvCustomizer, env runtime.Environment, app runtime.Application) {
+				env.PropertySources().PushBack(config.NewMapPropertySource("anyArgs", map[string]any{
+					"procyon.config.additional-location": "extra/app.yaml",
+				}))
+
+				mockApp := app.(*AnyMockApplication)
+				mockApp.On("ResourceResolver").Return(newYamlResourceResolver(map[string]string{
+					"resources/procyon.yaml": "app.name: demo\n",
+				}))
+			},
+			wantErr: errors.New("customize environment: config location optional:resources/;extra/app.yaml: " +
+				"load config for default profile: location extra/app.yaml: config file not found"),
+		},
+		{
+			name: "config import does not exist",
+			env:  NewEnvironment(),
+			app:  &AnyMockApplication{},
+			preCondition: func(customizer *configEnvCustomizer, env runtime.Environment, app runtime.Application) {
+				mockApp := app.(*AnyMockApplication)
+				mockApp.On("ResourceResolver").Return(newYamlResourceResolver(map[string]string{
+					"resources/procyon.yaml": "procyon.config.import: db.yaml\n",
+				}))
+			},
+			wantErr: errors.New("customize environment: config location optional:resources/: load config for default profile: " +
+				"config file resources/procyon.yaml: import resources/db.yaml: config file not found"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1047,6 +1244,11 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 			// given
 			customizer := newConfigEnvCustomizer()
 
+			// the cases only provide yaml config files
+			customizer.propSourceLoaders = slices.DeleteFunc(customizer.propSourceLoaders, func(comp *component.Component) bool {
+				return comp.Definition().Type() != reflect.TypeFor[*config.YamlPropertySourceLoader]()
+			})
+
 			if tc.preCondition != nil {
 				tc.preCondition(customizer, tc.env, tc.app)
 			}
@@ -1071,3 +1273,133 @@ func TestConfigEnvCustomizer_CustomizeEnvironment(t *testing.T) {
 		})
 	}
 }
+
+// newYamlResourceResolver creates a resource resolver resolving the given files with their contents. The
+// directories of the given files exist, whereas the other files do not.
+func newYamlResourceResolver(files map[string]string) *AnyMockResourceResolver {
+	noResource := &AnyMockResource{}
+	noResource.On("Exists").Return(false)
+
+	dir := &AnyMockResource{}
+	dir.On("Exists").Return(true)
+
+	resourceResolver := &AnyMockResourceResolver{}
+	for location, contents := range files {
+		resource := &AnyMockResource{}
+		resource.On("Exists").Return(true)
+		resource.On("Reader").Return(&FakeFile{contents: contents}, nil)
+		resource.On("Location").Return(location)
+
+		resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), location).Return(resource, nil)
+		resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), path.Dir(location)+"/.").Return(dir, nil)
+	}
+
+	resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), mock.Anything).Return(noResource, nil)
+	return resourceResolver
+}
+
+func TestConfigEnvCustomizer_CustomizeEnvironmentLoadsDotenv(t *testing.T) {
+	testCases := []struct {
+		name      string
+		files     map[string]string
+		envVars   map[string]string
+		props     map[string]any
+		wantName  string
+		wantFound bool
+		wantErr   error
+	}{
+		{
+			name:      "dotenv file",
+			files:     map[string]string{".env": "APP_NAME=dotenv"},
+			wantName:  "dotenv",
+			wantFound: true,
+		},
+		{
+			name:      "environment variable over dotenv file",
+			files:     map[string]string{".env": "APP_NAME=dotenv"},
+			envVars:   map[string]string{"APP_NAME": "env"},
+			wantName:  "env",
+			wantFound: true,
+		},
+		{
+			name: "dotenv file over config file",
+			files: map[string]string{
+				".env":                         "APP_NAME=dotenv",
+				"resources/procyon.properties": "app.name=config",
+			},
+			wantName:  "dotenv",
+			wantFound: true,
+		},
+		{
+			name: "profile activated by dotenv file",
+			files: map[string]string{
+				".env":                             "PROCYON_PROFILES_ACTIVE=dev",
+				"resources/procyon-dev.properties": "app.name=dev",
+			},
+			wantName:  "dev",
+			wantFound: true,
+		},
+		{
+			name: "disabled dotenv file",
+			files: map[string]string{
+				".env":                         "APP_NAME=dotenv",
+				"resources/procyon.properties": "app.name=config",
+			},
+			props:     map[string]any{DotenvEnabledProp: "false"},
+			wantName:  "config",
+			wantFound: true,
+		},
+		{
+			name:    "invalid dotenv enabled property",
+			files:   map[string]string{".env": "APP_NAME=dotenv"},
+			props:   map[string]any{DotenvEnabledProp: "sometimes"},
+			wantErr: errors.New("customize environment: invalid procyon.config.dotenv.enabled 'sometimes'"),
+		},
+		{
+			name:    "invalid dotenv file",
+			files:   map[string]string{".env": "APP NAME=dotenv"},
+			wantErr: errors.New("load dotenv property source \".env\": line 1: invalid variable name 'APP NAME'"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			dir := t.TempDir()
+			for file, content := range tc.files {
+				filePath := filepath.Join(dir, file)
+				require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0o755))
+				require.NoError(t, os.WriteFile(filePath, []byte(content), 0o644))
+			}
+
+			t.Chdir(dir)
+			for key, value := range tc.envVars {
+				t.Setenv(key, value)
+			}
+
+			env := NewEnvironment()
+			if len(tc.props) != 0 {
+				env.PropertySources().PushBack(config.NewMapPropertySource("anyProps", tc.props))
+			}
+			env.PropertySources().PushBack(runtime.NewEnvPropertySource())
+
+			customizer := newConfigEnvCustomizer()
+
+			// when
+			err := customizer.CustomizeEnvironment(env, New())
+
+			// then
+			if tc.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+
+			name, found := env.PropertyResolver().Lookup("app.name")
+			assert.Equal(t, tc.wantFound, found)
+			assert.Equal(t, tc.wantName, name)
+		})
+	}
+}
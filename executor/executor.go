@@ -0,0 +1,360 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	goruntime "runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	// ErrRejected is returned when a task is rejected because the queue of the executor is full.
+	ErrRejected = errors.New("task rejected")
+	// ErrShutdown is returned when a task is submitted to an executor that has been stopped.
+	ErrShutdown = errors.New("executor shut down")
+)
+
+// PanicError represents a panic raised by a task.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the goroutine that panicked.
+	Stack []byte
+}
+
+// Error returns the error message.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the value passed to panic if it is an error.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// Metrics represents a snapshot of the state of an executor.
+type Metrics struct {
+	// Workers is the number of worker goroutines.
+	Workers int
+	// QueueCapacity is the number of tasks that can wait for a worker.
+	QueueCapacity int
+	// Queued is the number of tasks waiting for a worker.
+	Queued int
+	// Active is the number of tasks being run.
+	Active int64
+	// Completed is the number of tasks that completed without an error.
+	Completed int64
+	// Failed is the number of tasks that returned an error or panicked.
+	Failed int64
+	// Rejected is the number of rejected or discarded tasks.
+	Rejected int64
+}
+
+// job represents a task waiting in the queue of an executor.
+type job struct {
+	ctx      context.Context
+	run      func(ctx context.Context) error
+	complete func(err error)
+	reject   func(err error)
+}
+
+// Executor runs tasks on a fixed number of worker goroutines, queueing the tasks submitted while all
+// workers are busy. It implements runtime.Lifecycle: tasks submitted before the executor starts wait
+// in the queue, and stopping the executor rejects new tasks and waits for the queued ones to finish.
+//
+// A task runs with the context it was submitted with, so the context values are propagated and the
+// task is skipped if the context is done before a worker picks it up. Panics are recovered and
+// reported as a PanicError.
+type Executor struct {
+	name   string
+	props  ExecutorProperties
+	queue  chan *job
+	stopCh chan struct{}
+
+	running    bool
+	stopped    bool
+	workers    sync.WaitGroup
+	submitting sync.WaitGroup
+	mu         sync.Mutex
+
+	active    atomic.Int64
+	completed atomic.Int64
+	failed    atomic.Int64
+	rejected  atomic.Int64
+}
+
+// NewExecutor creates a new Executor with the given name and properties. It panics if the
+// properties are invalid.
+func NewExecutor(name string, props ExecutorProperties) *Executor {
+	if name == "" {
+		panic("empty executor name")
+	}
+
+	if err := props.validate(); err != nil {
+		panic(err.Error())
+	}
+
+	if props.Workers == 0 {
+		props.Workers = goruntime.NumCPU()
+	}
+
+	if props.RejectionPolicy == "" {
+		props.RejectionPolicy = AbortPolicy
+	}
+
+	return &Executor{
+		name:   name,
+		props:  props,
+		queue:  make(chan *job, props.QueueSize),
+		stopCh: make(chan struct{}),
+	}
+}
+
+// Name returns the name of the executor.
+func (e *Executor) Name() string {
+	return e.name
+}
+
+// Execute submits the given task. It returns an error if the task is rejected. The errors
+// returned by the task are logged.
+func (e *Executor) Execute(ctx context.Context, task func(ctx context.Context) error) error {
+	if ctx == nil {
+		return errors.New("nil context")
+	}
+
+	if task == nil {
+		return errors.New("nil task")
+	}
+
+	return e.submit(&job{
+		ctx: ctx,
+		run: task,
+		complete: func(err error) {
+			if err != nil {
+				slog.Error("Task failed on executor", "executor", e.name, "error", err)
+			}
+		},
+		reject: func(error) {},
+	})
+}
+
+// Start starts the worker goroutines.
+func (e *Executor) Start(ctx context.Context) error {
+	if ctx == nil {
+		return errors.New("nil context")
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.running {
+		return nil
+	}
+
+	if e.stopped {
+		e.queue = make(chan *job, e.props.QueueSize)
+		e.stopCh = make(chan struct{})
+		e.stopped = false
+	}
+
+	for range e.props.Workers {
+		e.workers.Add(1)
+		go e.work(e.queue, e.stopCh)
+	}
+
+	e.running = true
+	return nil
+}
+
+// Stop stops accepting new tasks and waits for the running and queued tasks to finish. If the
+// given context is done before they finish, Stop returns its error.
+func (e *Executor) Stop(ctx context.Context) error {
+	if ctx == nil {
+		return errors.New("nil context")
+	}
+
+	e.mu.Lock()
+	if e.stopped {
+		e.mu.Unlock()
+		return nil
+	}
+
+	e.stopped = true
+	e.running = false
+	queue := e.queue
+	close(e.stopCh)
+	e.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		e.submitting.Wait()
+		e.workers.Wait()
+
+		// reject the tasks queued while the executor had no workers
+		for {
+			select {
+			case j := <-queue:
+				e.rejectJob(j, ErrShutdown)
+			default:
+				close(done)
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("stop executor %q: %w", e.name, ctx.Err())
+	}
+}
+
+// IsRunning indicates whether the executor is running.
+func (e *Executor) IsRunning() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.running
+}
+
+// Metrics returns a snapshot of the state of the executor.
+func (e *Executor) Metrics() Metrics {
+	e.mu.Lock()
+	queue := e.queue
+	e.mu.Unlock()
+
+	return Metrics{
+		Workers:       e.props.Workers,
+		QueueCapacity: e.props.QueueSize,
+		Queued:        len(queue),
+		Active:        e.active.Load(),
+		Completed:     e.completed.Load(),
+		Failed:        e.failed.Load(),
+		Rejected:      e.rejected.Load(),
+	}
+}
+
+// submit queues the given job, applying the rejection policy if the queue is full.
+func (e *Executor) submit(j *job) error {
+	e.mu.Lock()
+	if e.stopped {
+		e.mu.Unlock()
+		e.rejectJob(j, ErrShutdown)
+		return fmt.Errorf("executor %q: %w", e.name, ErrShutdown)
+	}
+
+	queue, stopCh := e.queue, e.stopCh
+	e.submitting.Add(1)
+	e.mu.Unlock()
+
+	defer e.submitting.Done()
+
+	select {
+	case queue <- j:
+		return nil
+	default:
+	}
+
+	switch e.props.RejectionPolicy {
+	case CallerRunsPolicy:
+		e.runJob(j)
+		return nil
+	case DiscardPolicy:
+		e.rejectJob(j, ErrRejected)
+		return nil
+	case BlockPolicy:
+		select {
+		case queue <- j:
+			return nil
+		case <-j.ctx.Done():
+			e.rejectJob(j, j.ctx.Err())
+			return fmt.Errorf("executor %q: %w", e.name, j.ctx.Err())
+		case <-stopCh:
+			e.rejectJob(j, ErrShutdown)
+			return fmt.Errorf("executor %q: %w", e.name, ErrShutdown)
+		}
+	default:
+		e.rejectJob(j, ErrRejected)
+		return fmt.Errorf("executor %q: %w", e.name, ErrRejected)
+	}
+}
+
+// work runs the queued jobs until the executor is stopped and the queue is drained.
+func (e *Executor) work(queue chan *job, stopCh chan struct{}) {
+	defer e.workers.Done()
+
+	for {
+		select {
+		case j := <-queue:
+			e.runJob(j)
+		case <-stopCh:
+			for {
+				select {
+				case j := <-queue:
+					e.runJob(j)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// runJob runs the given job unless its context is already done.
+func (e *Executor) runJob(j *job) {
+	if err := j.ctx.Err(); err != nil {
+		e.rejectJob(j, err)
+		return
+	}
+
+	e.active.Add(1)
+	err := e.invoke(j)
+	e.active.Add(-1)
+
+	if err != nil {
+		e.failed.Add(1)
+	} else {
+		e.completed.Add(1)
+	}
+
+	j.complete(err)
+}
+
+// invoke calls the job, converting a panic into a PanicError.
+func (e *Executor) invoke(j *job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+
+	return j.run(j.ctx)
+}
+
+// rejectJob notifies the given job that it will not run.
+func (e *Executor) rejectJob(j *job, err error) {
+	e.rejected.Add(1)
+	j.reject(err)
+}
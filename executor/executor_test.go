@@ -0,0 +1,376 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type anyContextKey struct{}
+
+func newAnyExecutor(t *testing.T, props ExecutorProperties) *Executor {
+	executor := NewExecutor("anyExecutor", props)
+	t.Cleanup(func() {
+		_ = executor.Stop(context.Background())
+	})
+
+	return executor
+}
+
+func TestNewExecutor(t *testing.T) {
+	testCases := []struct {
+		name      string
+		executor  string
+		props     ExecutorProperties
+		wantPanic error
+	}{
+		{
+			name:      "empty name",
+			wantPanic: errors.New("empty executor name"),
+		},
+		{
+			name:      "negative workers",
+			executor:  "anyExecutor",
+			props:     ExecutorProperties{Workers: -1},
+			wantPanic: errors.New("negative workers -1"),
+		},
+		{
+			name:      "negative queue size",
+			executor:  "anyExecutor",
+			props:     ExecutorProperties{QueueSize: -1},
+			wantPanic: errors.New("negative queue size -1"),
+		},
+		{
+			name:      "unknown rejection policy",
+			executor:  "anyExecutor",
+			props:     ExecutorProperties{RejectionPolicy: "anyPolicy"},
+			wantPanic: errors.New("unknown rejection policy 'anyPolicy'"),
+		},
+		{
+			name:     "valid arguments",
+			executor: "anyExecutor",
+			props:    ExecutorProperties{Workers: 2, QueueSize: 10, RejectionPolicy: BlockPolicy},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+
+			// when
+			if tc.wantPanic != nil {
+				require.PanicsWithValue(t, tc.wantPanic.Error(), func() {
+					NewExecutor(tc.executor, tc.props)
+				})
+				return
+			}
+
+			executor := NewExecutor(tc.executor, tc.props)
+
+			// then
+			require.NotNil(t, executor)
+			assert.Equal(t, tc.executor, executor.Name())
+			assert.False(t, executor.IsRunning())
+			assert.Equal(t, Metrics{Workers: 2, QueueCapacity: 10}, executor.Metrics())
+		})
+	}
+}
+
+func TestNewExecutor_DefaultWorkers(t *testing.T) {
+	// given
+
+	// when
+	executor := NewExecutor("anyExecutor", ExecutorProperties{})
+
+	// then
+	assert.Positive(t, executor.Metrics().Workers)
+}
+
+func TestExecutor_Execute(t *testing.T) {
+	// given
+	executor := newAnyExecutor(t, ExecutorProperties{Workers: 1, QueueSize: 1})
+	values := make(chan any, 1)
+
+	// when
+	nilCtxErr := executor.Execute(nil, func(ctx context.Context) error {
+		return nil
+	})
+	nilTaskErr := executor.Execute(context.Background(), nil)
+
+	ctx := context.WithValue(context.Background(), anyContextKey{}, "anyValue")
+	err := executor.Execute(ctx, func(ctx context.Context) error {
+		values <- ctx.Value(anyContextKey{})
+		return nil
+	})
+
+	// then
+	assert.EqualError(t, nilCtxErr, "nil context")
+	assert.EqualError(t, nilTaskErr, "nil task")
+	require.NoError(t, err)
+	assert.Equal(t, 1, executor.Metrics().Queued)
+
+	require.NoError(t, executor.Start(context.Background()))
+	assert.True(t, executor.IsRunning())
+	assert.Equal(t, "anyValue", <-values)
+}
+
+func TestExecutor_RejectionPolicies(t *testing.T) {
+	testCases := []struct {
+		name       string
+		policy     RejectionPolicy
+		wantErr    error
+		wantRan    bool
+		wantReject int64
+	}{
+		{
+			name:       "abort",
+			policy:     AbortPolicy,
+			wantErr:    errors.New("executor \"anyExecutor\": task rejected"),
+			wantReject: 1,
+		},
+		{
+			name:       "discard",
+			policy:     DiscardPolicy,
+			wantReject: 1,
+		},
+		{
+			name:    "caller runs",
+			policy:  CallerRunsPolicy,
+			wantRan: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			executor := newAnyExecutor(t, ExecutorProperties{Workers: 1, QueueSize: 1, RejectionPolicy: tc.policy})
+			require.NoError(t, executor.Execute(context.Background(), func(ctx context.Context) error {
+				return nil
+			}))
+
+			// when
+			var ran atomic.Bool
+			err := executor.Execute(context.Background(), func(ctx context.Context) error {
+				ran.Store(true)
+				return nil
+			})
+
+			// then
+			if tc.wantErr != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.wantErr.Error())
+				assert.ErrorIs(t, err, ErrRejected)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.wantRan, ran.Load())
+			assert.Equal(t, tc.wantReject, executor.Metrics().Rejected)
+		})
+	}
+}
+
+func TestExecutor_BlockPolicy(t *testing.T) {
+	// given
+	executor := newAnyExecutor(t, ExecutorProperties{Workers: 1, QueueSize: 1, RejectionPolicy: BlockPolicy})
+	require.NoError(t, executor.Execute(context.Background(), func(ctx context.Context) error {
+		return nil
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// when
+	err := executor.Execute(ctx, func(ctx context.Context) error {
+		return nil
+	})
+
+	// then
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	done := make(chan error)
+	go func() {
+		done <- executor.Execute(context.Background(), func(ctx context.Context) error {
+			return nil
+		})
+	}()
+
+	require.NoError(t, executor.Start(context.Background()))
+	require.NoError(t, <-done)
+}
+
+func TestExecutor_Stop(t *testing.T) {
+	// given
+	var completed atomic.Int32
+	executor := newAnyExecutor(t, ExecutorProperties{Workers: 2, QueueSize: 10})
+	require.NoError(t, executor.Start(context.Background()))
+
+	for range 5 {
+		err := executor.Execute(context.Background(), func(ctx context.Context) error {
+			time.Sleep(5 * time.Millisecond)
+			completed.Add(1)
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	// when
+	nilCtxErr := executor.Stop(nil)
+	err := executor.Stop(context.Background())
+
+	// then
+	assert.EqualError(t, nilCtxErr, "nil context")
+	require.NoError(t, err)
+	assert.False(t, executor.IsRunning())
+	assert.Equal(t, int32(5), completed.Load())
+	assert.Equal(t, int64(5), executor.Metrics().Completed)
+
+	err = executor.Execute(context.Background(), func(ctx context.Context) error {
+		return nil
+	})
+	assert.EqualError(t, err, "executor \"anyExecutor\": executor shut down")
+	assert.ErrorIs(t, err, ErrShutdown)
+}
+
+func TestExecutor_Stop_Timeout(t *testing.T) {
+	// given
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+
+	executor := newAnyExecutor(t, ExecutorProperties{Workers: 1, QueueSize: 1})
+	require.NoError(t, executor.Start(context.Background()))
+	require.NoError(t, executor.Execute(context.Background(), func(ctx context.Context) error {
+		close(started)
+		<-release
+		return nil
+	}))
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// when
+	err := executor.Stop(ctx)
+
+	// then
+	require.Error(t, err)
+	assert.EqualError(t, err, "stop executor \"anyExecutor\": context deadline exceeded")
+}
+
+func TestExecutor_Stop_RejectsQueuedTasksWithoutWorkers(t *testing.T) {
+	// given
+	executor := newAnyExecutor(t, ExecutorProperties{Workers: 1, QueueSize: 1})
+	future := Submit(context.Background(), executor, func(ctx context.Context) (int, error) {
+		return 1, nil
+	})
+
+	// when
+	err := executor.Stop(context.Background())
+
+	// then
+	require.NoError(t, err)
+
+	_, err = future.Get(context.Background())
+	assert.ErrorIs(t, err, ErrShutdown)
+}
+
+func TestExecutor_Restart(t *testing.T) {
+	// given
+	executor := newAnyExecutor(t, ExecutorProperties{Workers: 1, QueueSize: 1})
+	require.NoError(t, executor.Start(context.Background()))
+	require.NoError(t, executor.Stop(context.Background()))
+
+	// when
+	err := executor.Start(context.Background())
+
+	// then
+	require.NoError(t, err)
+
+	value, err := Submit(context.Background(), executor, func(ctx context.Context) (string, error) {
+		return "anyValue", nil
+	}).Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "anyValue", value)
+}
+
+func TestExecutor_FailuresAndPanics(t *testing.T) {
+	// given
+	executor := newAnyExecutor(t, ExecutorProperties{Workers: 1, QueueSize: 2})
+	require.NoError(t, executor.Start(context.Background()))
+
+	// when
+	failed := Submit(context.Background(), executor, func(ctx context.Context) (int, error) {
+		return 0, errors.New("any error")
+	})
+
+	panicked := Submit(context.Background(), executor, func(ctx context.Context) (int, error) {
+		panic("any panic")
+	})
+
+	// then
+	_, err := failed.Get(context.Background())
+	assert.EqualError(t, err, "any error")
+
+	_, err = panicked.Get(context.Background())
+	require.Error(t, err)
+	assert.EqualError(t, err, "panic: any panic")
+
+	var panicErr *PanicError
+	require.ErrorAs(t, err, &panicErr)
+	assert.Equal(t, "any panic", panicErr.Value)
+	assert.NotEmpty(t, panicErr.Stack)
+
+	require.NoError(t, executor.Stop(context.Background()))
+	assert.Equal(t, int64(2), executor.Metrics().Failed)
+}
+
+func TestExecutor_SkipsTasksWithDoneContext(t *testing.T) {
+	// given
+	executor := newAnyExecutor(t, ExecutorProperties{Workers: 1, QueueSize: 1})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	future := Submit(ctx, executor, func(ctx context.Context) (int, error) {
+		return 1, nil
+	})
+	cancel()
+
+	// when
+	require.NoError(t, executor.Start(context.Background()))
+
+	// then
+	_, err := future.Get(context.Background())
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestPanicError_Unwrap(t *testing.T) {
+	// given
+	cause := errors.New("any error")
+
+	// when
+	err := &PanicError{Value: cause}
+
+	// then
+	assert.ErrorIs(t, err, cause)
+	assert.Nil(t, (&PanicError{Value: "any panic"}).Unwrap())
+}
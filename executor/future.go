@@ -0,0 +1,131 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Future represents the result of a task submitted using Submit.
+type Future[T any] struct {
+	done  chan struct{}
+	once  sync.Once
+	value T
+	err   error
+}
+
+// newFuture creates a new Future that is not completed yet.
+func newFuture[T any]() *Future[T] {
+	return &Future[T]{
+		done: make(chan struct{}),
+	}
+}
+
+// Submit submits the given task to the executor and returns a Future for its result. If the task
+// is rejected, the future completes with the rejection error.
+func Submit[T any](ctx context.Context, executor *Executor, task func(ctx context.Context) (T, error)) *Future[T] {
+	future := newFuture[T]()
+
+	switch {
+	case ctx == nil:
+		future.complete(errors.New("nil context"))
+		return future
+	case executor == nil:
+		future.complete(errors.New("nil executor"))
+		return future
+	case task == nil:
+		future.complete(errors.New("nil task"))
+		return future
+	}
+
+	_ = executor.submit(&job{
+		ctx: ctx,
+		run: func(ctx context.Context) (err error) {
+			future.value, err = task(ctx)
+			return err
+		},
+		complete: future.complete,
+		reject:   future.complete,
+	})
+
+	return future
+}
+
+// Done returns a channel that is closed when the task completes.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Get waits for the task to complete and returns its result. If the given context is done
+// before the task completes, Get returns the error of the context.
+func (f *Future[T]) Get(ctx context.Context) (T, error) {
+	var zeroVal T
+
+	if ctx == nil {
+		return zeroVal, errors.New("nil context")
+	}
+
+	select {
+	case <-f.done:
+		if f.err != nil {
+			return zeroVal, f.err
+		}
+
+		return f.value, nil
+	case <-ctx.Done():
+		return zeroVal, ctx.Err()
+	}
+}
+
+// complete completes the future with the given error.
+func (f *Future[T]) complete(err error) {
+	f.once.Do(func() {
+		f.err = err
+		close(f.done)
+	})
+}
+
+// AwaitAll waits for all the given futures to complete and returns their results in the same
+// order. If any task fails, it returns the errors of the failed tasks joined together.
+func AwaitAll[T any](ctx context.Context, futures ...*Future[T]) ([]T, error) {
+	if ctx == nil {
+		return nil, errors.New("nil context")
+	}
+
+	values := make([]T, len(futures))
+	errs := make([]error, 0)
+
+	for index, future := range futures {
+		if future == nil {
+			return nil, errors.New("nil future")
+		}
+
+		value, err := future.Get(ctx)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+
+			errs = append(errs, err)
+			continue
+		}
+
+		values[index] = value
+	}
+
+	return values, errors.Join(errs...)
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmit(t *testing.T) {
+	task := func(ctx context.Context) (int, error) {
+		return 1, nil
+	}
+
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		executor bool
+		task     func(ctx context.Context) (int, error)
+		wantErr  error
+	}{
+		{
+			name:     "nil context",
+			executor: true,
+			task:     task,
+			wantErr:  errors.New("nil context"),
+		},
+		{
+			name:    "nil executor",
+			ctx:     context.Background(),
+			task:    task,
+			wantErr: errors.New("nil executor"),
+		},
+		{
+			name:     "nil task",
+			ctx:      context.Background(),
+			executor: true,
+			wantErr:  errors.New("nil task"),
+		},
+		{
+			name:     "valid arguments",
+			ctx:      context.Background(),
+			executor: true,
+			task:     task,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var executor *Executor
+			if tc.executor {
+				executor = newAnyExecutor(t, ExecutorProperties{Workers: 1, QueueSize: 1})
+				require.NoError(t, executor.Start(context.Background()))
+			}
+
+			// when
+			future := Submit(tc.ctx, executor, tc.task)
+
+			// then
+			value, err := future.Get(context.Background())
+			if tc.wantErr != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 1, value)
+		})
+	}
+}
+
+func TestFuture_Get(t *testing.T) {
+	// given
+	future := newFuture[int]()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// when
+	_, nilCtxErr := future.Get(nil)
+	_, err := future.Get(ctx)
+
+	// then
+	assert.EqualError(t, nilCtxErr, "nil context")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	select {
+	case <-future.Done():
+		t.Fatal("future must not be completed")
+	default:
+	}
+
+	future.value = 1
+	future.complete(nil)
+	future.complete(errors.New("any error"))
+
+	<-future.Done()
+	value, err := future.Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, value)
+}
+
+func TestAwaitAll(t *testing.T) {
+	// given
+	executor := newAnyExecutor(t, ExecutorProperties{Workers: 4, QueueSize: 10})
+	require.NoError(t, executor.Start(context.Background()))
+
+	futures := make([]*Future[int], 0)
+	for index := range 5 {
+		futures = append(futures, Submit(context.Background(), executor, func(ctx context.Context) (int, error) {
+			if index == 3 {
+				return 0, errors.New("any error")
+			}
+
+			return index * index, nil
+		}))
+	}
+
+	// when
+	values, err := AwaitAll(context.Background(), futures...)
+
+	// then
+	require.Error(t, err)
+	assert.EqualError(t, err, "any error")
+	assert.Equal(t, []int{0, 1, 4, 0, 16}, values)
+
+	_, err = AwaitAll[int](nil)
+	assert.EqualError(t, err, "nil context")
+
+	_, err = AwaitAll[int](context.Background(), nil)
+	assert.EqualError(t, err, "nil future")
+}
+
+func TestAwaitAll_ContextDone(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// when
+	values, err := AwaitAll(ctx, newFuture[int]())
+
+	// then
+	assert.Nil(t, values)
+	assert.ErrorIs(t, err, context.Canceled)
+}
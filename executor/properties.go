@@ -0,0 +1,76 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"fmt"
+	"slices"
+)
+
+// RejectionPolicy defines what happens to a task submitted while the queue of an executor is full.
+type RejectionPolicy string
+
+const (
+	// AbortPolicy rejects the task with ErrRejected.
+	AbortPolicy RejectionPolicy = "abort"
+	// CallerRunsPolicy runs the task in the goroutine of the caller.
+	CallerRunsPolicy RejectionPolicy = "caller-runs"
+	// DiscardPolicy silently discards the task. The future of a discarded task completes with ErrRejected.
+	DiscardPolicy RejectionPolicy = "discard"
+	// BlockPolicy blocks the caller until the queue has room or the context of the task is done.
+	BlockPolicy RejectionPolicy = "block"
+)
+
+// rejectionPolicies contains the supported rejection policies.
+var rejectionPolicies = []RejectionPolicy{AbortPolicy, CallerRunsPolicy, DiscardPolicy, BlockPolicy}
+
+// ExecutorProperties defines the configuration of an executor.
+type ExecutorProperties struct {
+	// Workers is the number of worker goroutines. If zero, the number of CPUs is used.
+	Workers int `property:"workers,optional"`
+	// QueueSize is the number of tasks that can wait for a worker. If zero, a task is
+	// only accepted if a worker is ready to run it.
+	QueueSize int `property:"queueSize,default=100"`
+	// RejectionPolicy defines what happens to a task submitted while the queue is full.
+	RejectionPolicy RejectionPolicy `property:"rejectionPolicy,default='abort'"`
+}
+
+// validate checks the configuration values.
+func (p ExecutorProperties) validate() error {
+	if p.Workers < 0 {
+		return fmt.Errorf("negative workers %d", p.Workers)
+	}
+
+	if p.QueueSize < 0 {
+		return fmt.Errorf("negative queue size %d", p.QueueSize)
+	}
+
+	if p.RejectionPolicy != "" && !slices.Contains(rejectionPolicies, p.RejectionPolicy) {
+		return fmt.Errorf("unknown rejection policy '%s'", p.RejectionPolicy)
+	}
+
+	return nil
+}
+
+// ExecutorsProperties defines the executors to be registered as components, keyed by their name.
+type ExecutorsProperties struct {
+	// Executors contains the executor configurations keyed by the executor name.
+	Executors map[string]ExecutorProperties `property:"executors,optional"`
+}
+
+// Prefix returns the configuration property prefix.
+func (p *ExecutorsProperties) Prefix() string {
+	return "procyon"
+}
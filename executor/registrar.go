@@ -0,0 +1,80 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"codnect.io/procyon/component"
+	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
+)
+
+// executorRegistrar registers the executors configured under procyon.executors as components
+// named after their configuration key, e.g. procyon.executors.mail.workers configures the
+// executor named mail.
+type executorRegistrar struct {
+	env runtime.Environment
+}
+
+// newExecutorRegistrar creates a new executorRegistrar with the given environment.
+func newExecutorRegistrar(env runtime.Environment) *executorRegistrar {
+	if env == nil {
+		panic("nil environment")
+	}
+
+	return &executorRegistrar{
+		env: env,
+	}
+}
+
+// CustomizeContainer binds the executor properties and registers a component definition for each
+// configured executor. The properties are bound here as the container customizers run before the
+// config properties are processed.
+func (r *executorRegistrar) CustomizeContainer(container component.Container) error {
+	props := &ExecutorsProperties{}
+
+	binder := config.NewDefaultPropertyBinder(r.env.PropertySources())
+	if err := binder.Bind(props.Prefix(), props); err != nil {
+		return fmt.Errorf("bind executor properties: %w", err)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(props.Executors)) {
+		executorProps := props.Executors[name]
+
+		if err := executorProps.validate(); err != nil {
+			return fmt.Errorf("executor %q: %w", name, err)
+		}
+
+		def, err := component.MakeDefinition(func() *Executor {
+			return NewExecutor(name, executorProps)
+		}, component.WithName(name))
+
+		if err != nil {
+			return fmt.Errorf("executor %q: %w", name, err)
+		}
+
+		if err = container.RegisterDefinition(def); err != nil {
+			return fmt.Errorf("executor %q: %w", name, err)
+		}
+
+		slog.Debug("Registered executor", "executor", name)
+	}
+
+	return nil
+}
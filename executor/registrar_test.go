@@ -0,0 +1,131 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"codnect.io/procyon"
+	"codnect.io/procyon/component"
+	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAnyEnvironment(props map[string]any) *procyon.Environment {
+	env := procyon.NewEnvironment()
+	env.PropertySources().PushFront(config.NewMapPropertySource("anyPropertySource", props))
+	return env
+}
+
+func TestNewExecutorRegistrar(t *testing.T) {
+	require.PanicsWithValue(t, "nil environment", func() {
+		newExecutorRegistrar(nil)
+	})
+}
+
+func TestExecutorRegistrar_CustomizeContainer(t *testing.T) {
+	testCases := []struct {
+		name    string
+		props   map[string]any
+		wantErr error
+	}{
+		{
+			name: "invalid workers",
+			props: map[string]any{
+				"procyon.executors.mail.workers": "many",
+			},
+			wantErr: errors.New("bind executor properties"),
+		},
+		{
+			name: "invalid executor",
+			props: map[string]any{
+				"procyon.executors.mail.rejectionPolicy": "anyPolicy",
+			},
+			wantErr: errors.New("executor \"mail\": unknown rejection policy 'anyPolicy'"),
+		},
+		{
+			name: "duplicate component",
+			props: map[string]any{
+				"procyon.executors.existing.workers": 1,
+			},
+			wantErr: errors.New("executor \"existing\""),
+		},
+		{
+			name:  "no executors",
+			props: map[string]any{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			container := component.NewStandardContainer()
+			def, err := component.MakeDefinition(func() *Executor {
+				return NewExecutor("existing", ExecutorProperties{})
+			}, component.WithName("existing"))
+			require.NoError(t, err)
+			require.NoError(t, container.RegisterDefinition(def))
+
+			registrar := newExecutorRegistrar(newAnyEnvironment(tc.props))
+
+			// when
+			err = registrar.CustomizeContainer(container)
+
+			// then
+			if tc.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, []string{"existing"}, container.DefinitionNames())
+		})
+	}
+}
+
+func TestExecutorRegistrar_RegistersExecutors(t *testing.T) {
+	// given
+	container := component.NewStandardContainer()
+	registrar := newExecutorRegistrar(newAnyEnvironment(map[string]any{
+		"procyon.executors.mail.workers":         2,
+		"procyon.executors.mail.queueSize":       5,
+		"procyon.executors.mail.rejectionPolicy": "caller-runs",
+		"procyon.executors.reports.workers":      1,
+	}))
+
+	// when
+	err := registrar.CustomizeContainer(container)
+
+	// then
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"mail", "reports"}, container.DefinitionNamesOf(reflect.TypeFor[runtime.Lifecycle]()))
+
+	mail, err := component.Resolve[*Executor](context.Background(), container, "mail")
+	require.NoError(t, err)
+	assert.Equal(t, "mail", mail.Name())
+	assert.Equal(t, Metrics{Workers: 2, QueueCapacity: 5}, mail.Metrics())
+	assert.Equal(t, CallerRunsPolicy, mail.props.RejectionPolicy)
+
+	reports, err := component.Resolve[*Executor](context.Background(), container, "reports")
+	require.NoError(t, err)
+	assert.Equal(t, Metrics{Workers: 1, QueueCapacity: 100}, reports.Metrics())
+	assert.Equal(t, AbortPolicy, reports.props.RejectionPolicy)
+}
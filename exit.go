@@ -0,0 +1,82 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"errors"
+	"os"
+
+	"codnect.io/procyon/runtime"
+)
+
+var (
+	// osExit is the function used to terminate the process.
+	osExit = os.Exit
+)
+
+// Exit runs the application with the given command-line arguments and terminates the process
+// with the exit code computed from the run result. See Application.ExitCode for details.
+func Exit(app *Application, args ...string) {
+	if app == nil {
+		panic("nil application")
+	}
+
+	_ = app.Run(args...)
+	osExit(app.ExitCode())
+}
+
+// exitCodeOf computes the exit code for the given run error. An error implementing
+// runtime.ExitCodeGenerator provides its own code; otherwise the code generated by
+// the components is used, falling back to 1 if the run failed.
+func exitCodeOf(err error, componentsCode int) int {
+	if err == nil {
+		return componentsCode
+	}
+
+	var generator runtime.ExitCodeGenerator
+	if errors.As(err, &generator) {
+		if code := generator.ExitCode(); code != 0 {
+			return code
+		}
+	}
+
+	if componentsCode != 0 {
+		return componentsCode
+	}
+
+	return 1
+}
+
+// aggregateExitCodes combines the given exit codes into a single one. The code with the
+// largest magnitude wins; if several have the same magnitude, the first one is used.
+func aggregateExitCodes(codes ...int) int {
+	result := 0
+	for _, code := range codes {
+		if abs(code) > abs(result) {
+			result = code
+		}
+	}
+
+	return result
+}
+
+// abs returns the absolute value of the given integer.
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
@@ -0,0 +1,239 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"codnect.io/procyon/component"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type anyExitCodeError struct {
+	code int
+}
+
+func (e *anyExitCodeError) Error() string {
+	return "any exit code error"
+}
+
+func (e *anyExitCodeError) ExitCode() int {
+	return e.code
+}
+
+type anyExitCodeGenerator struct {
+	code int
+}
+
+func (g *anyExitCodeGenerator) ExitCode() int {
+	return g.code
+}
+
+func TestExit(t *testing.T) {
+	testCases := []struct {
+		name         string
+		app          *Application
+		preCondition func(app *Application)
+		wantPanic    error
+		wantCode     int
+	}{
+		{
+			name:      "nil application",
+			wantPanic: errors.New("nil application"),
+		},
+		{
+			name:     "successful run",
+			app:      New(),
+			wantCode: 0,
+		},
+		{
+			name: "failed run",
+			app:  New(),
+			preCondition: func(app *Application) {
+				bannerPrinter := &AnyMockBannerPrinter{}
+				bannerPrinter.On("Print", mock.Anything, mock.Anything).Return(errors.New("banner printer error"))
+
+				app.SetBannerPrinter(bannerPrinter)
+			},
+			wantCode: 1,
+		},
+		{
+			name: "exit code generator components",
+			app:  New(),
+			preCondition: func(app *Application) {
+				container := component.NewStandardContainer()
+
+				err := container.RegisterSingleton("anyExitCodeGenerator", &anyExitCodeGenerator{code: 3})
+				require.NoError(t, err)
+
+				err = container.RegisterSingleton("anotherExitCodeGenerator", &anyExitCodeGenerator{code: -5})
+				require.NoError(t, err)
+
+				app.startupContainer = container
+			},
+			wantCode: -5,
+		},
+		{
+			name: "exit code generator error",
+			app:  New(),
+			preCondition: func(app *Application) {
+				container := component.NewStandardContainer()
+
+				runner := &AnyMockCommandLineRunner{}
+				runner.On("Run", mock.Anything, mock.Anything).Return(fmt.Errorf("run: %w", &anyExitCodeError{code: 42}))
+
+				err := container.RegisterSingleton("anyCommandLineRunner", runner)
+				require.NoError(t, err)
+
+				app.startupContainer = container
+			},
+			wantCode: 42,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			if tc.preCondition != nil {
+				tc.preCondition(tc.app)
+			}
+
+			exitCode := -1
+			osExit = func(code int) {
+				exitCode = code
+			}
+
+			defer func() {
+				osExit = os.Exit
+			}()
+
+			// when
+			if tc.wantPanic != nil {
+				require.PanicsWithValue(t, tc.wantPanic.Error(), func() {
+					Exit(tc.app)
+				})
+				return
+			}
+
+			Exit(tc.app)
+
+			// then
+			assert.Equal(t, tc.wantCode, exitCode)
+		})
+	}
+}
+
+func TestExitCodeOf(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            error
+		componentsCode int
+		wantCode       int
+	}{
+		{
+			name:     "no error",
+			wantCode: 0,
+		},
+		{
+			name:           "no error with components code",
+			componentsCode: 2,
+			wantCode:       2,
+		},
+		{
+			name:     "error",
+			err:      errors.New("any error"),
+			wantCode: 1,
+		},
+		{
+			name:           "error with components code",
+			err:            errors.New("any error"),
+			componentsCode: 2,
+			wantCode:       2,
+		},
+		{
+			name:           "exit code generator error",
+			err:            fmt.Errorf("wrapped: %w", &anyExitCodeError{code: 7}),
+			componentsCode: 2,
+			wantCode:       7,
+		},
+		{
+			name:     "exit code generator error with zero code",
+			err:      &anyExitCodeError{code: 0},
+			wantCode: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+
+			// when
+			code := exitCodeOf(tc.err, tc.componentsCode)
+
+			// then
+			assert.Equal(t, tc.wantCode, code)
+		})
+	}
+}
+
+func TestAggregateExitCodes(t *testing.T) {
+	testCases := []struct {
+		name     string
+		codes    []int
+		wantCode int
+	}{
+		{
+			name:     "no codes",
+			wantCode: 0,
+		},
+		{
+			name:     "zero codes",
+			codes:    []int{0, 0},
+			wantCode: 0,
+		},
+		{
+			name:     "highest positive code",
+			codes:    []int{1, 3, 2},
+			wantCode: 3,
+		},
+		{
+			name:     "lowest negative code",
+			codes:    []int{-1, 2, -4},
+			wantCode: -4,
+		},
+		{
+			name:     "same magnitude",
+			codes:    []int{-2, 2},
+			wantCode: -2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+
+			// when
+			code := aggregateExitCodes(tc.codes...)
+
+			// then
+			assert.Equal(t, tc.wantCode, code)
+		})
+	}
+}
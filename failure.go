@@ -0,0 +1,171 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"syscall"
+
+	"codnect.io/procyon/component"
+	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
+)
+
+// missingPropertyFailureAnalyzer analyzes failures caused by required properties that are not set.
+type missingPropertyFailureAnalyzer struct {
+}
+
+// newMissingPropertyFailureAnalyzer creates a new missingPropertyFailureAnalyzer.
+func newMissingPropertyFailureAnalyzer() *missingPropertyFailureAnalyzer {
+	return &missingPropertyFailureAnalyzer{}
+}
+
+// Analyze returns an analysis if the error is caused by a missing required property.
+func (a *missingPropertyFailureAnalyzer) Analyze(err error) *runtime.FailureAnalysis {
+	var missingErr *config.MissingPropertyError
+	if !errors.As(err, &missingErr) {
+		return nil
+	}
+
+	return &runtime.FailureAnalysis{
+		Description: fmt.Sprintf("Required property '%s' is not set.\n\nReason: %s", missingErr.Name, err),
+		Action: fmt.Sprintf("Define '%s' in a configuration file, an environment variable or a command-line argument, "+
+			"or mark the property as optional or give it a default value.", missingErr.Name),
+		Cause: err,
+	}
+}
+
+// invalidPropertiesFailureAnalyzer analyzes failures caused by bound properties that do not satisfy their constraints.
+type invalidPropertiesFailureAnalyzer struct {
+}
+
+// newInvalidPropertiesFailureAnalyzer creates a new invalidPropertiesFailureAnalyzer.
+func newInvalidPropertiesFailureAnalyzer() *invalidPropertiesFailureAnalyzer {
+	return &invalidPropertiesFailureAnalyzer{}
+}
+
+// Analyze returns an analysis listing every invalid property if the error is caused by a validation failure.
+func (a *invalidPropertiesFailureAnalyzer) Analyze(err error) *runtime.FailureAnalysis {
+	var validationErr *config.ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("The following properties are not valid:\n")
+
+	for _, violation := range validationErr.Violations {
+		sb.WriteString("\n    - ")
+		sb.WriteString(violation.String())
+	}
+
+	return &runtime.FailureAnalysis{
+		Description: sb.String(),
+		Action:      "Update the configuration so that the properties satisfy their constraints.",
+		Cause:       err,
+	}
+}
+
+// ambiguousComponentFailureAnalyzer analyzes failures caused by dependencies matching multiple components.
+type ambiguousComponentFailureAnalyzer struct {
+}
+
+// newAmbiguousComponentFailureAnalyzer creates a new ambiguousComponentFailureAnalyzer.
+func newAmbiguousComponentFailureAnalyzer() *ambiguousComponentFailureAnalyzer {
+	return &ambiguousComponentFailureAnalyzer{}
+}
+
+// Analyze returns an analysis if the error is caused by an ambiguous component match.
+func (a *ambiguousComponentFailureAnalyzer) Analyze(err error) *runtime.FailureAnalysis {
+	if !errors.Is(err, component.ErrAmbiguousMatch) {
+		return nil
+	}
+
+	return &runtime.FailureAnalysis{
+		Description: fmt.Sprintf("A dependency matched more than one component.\n\nReason: %s", err),
+		Action: "Remove the extra components, or qualify the dependency by name using " +
+			"component.WithQualifierFor or component.WithQualifierAt.",
+		Cause: err,
+	}
+}
+
+// portInUseFailureAnalyzer analyzes failures caused by a server port that is already in use.
+type portInUseFailureAnalyzer struct {
+}
+
+// newPortInUseFailureAnalyzer creates a new portInUseFailureAnalyzer.
+func newPortInUseFailureAnalyzer() *portInUseFailureAnalyzer {
+	return &portInUseFailureAnalyzer{}
+}
+
+// Analyze returns an analysis if the error is caused by an address that is already in use.
+func (a *portInUseFailureAnalyzer) Analyze(err error) *runtime.FailureAnalysis {
+	if !errors.Is(err, syscall.EADDRINUSE) {
+		return nil
+	}
+
+	return &runtime.FailureAnalysis{
+		Description: fmt.Sprintf("The server failed to start because the port is already in use.\n\nReason: %s", err),
+		Action: "Identify and stop the process that is listening on the port, " +
+			"or configure this application to listen on another port using the 'server.port' property.",
+		Cause: err,
+	}
+}
+
+// unresolvedPlaceholderFailureAnalyzer analyzes failures caused by placeholders that cannot be resolved.
+type unresolvedPlaceholderFailureAnalyzer struct {
+}
+
+// newUnresolvedPlaceholderFailureAnalyzer creates a new unresolvedPlaceholderFailureAnalyzer.
+func newUnresolvedPlaceholderFailureAnalyzer() *unresolvedPlaceholderFailureAnalyzer {
+	return &unresolvedPlaceholderFailureAnalyzer{}
+}
+
+// Analyze returns an analysis if the error is caused by an unresolved placeholder.
+func (a *unresolvedPlaceholderFailureAnalyzer) Analyze(err error) *runtime.FailureAnalysis {
+	var placeholderErr *config.UnresolvedPlaceholderError
+	if !errors.As(err, &placeholderErr) {
+		return nil
+	}
+
+	return &runtime.FailureAnalysis{
+		Description: fmt.Sprintf("Placeholder '${%s}' could not be resolved.\n\nReason: %s", placeholderErr.Name, err),
+		Action: fmt.Sprintf("Define '%s' in a configuration file, an environment variable or a command-line argument.",
+			placeholderErr.Name),
+		Cause: err,
+	}
+}
+
+// formatFailureAnalysis formats the given failure analysis as a human-readable report.
+func formatFailureAnalysis(analysis *runtime.FailureAnalysis) string {
+	var sb strings.Builder
+
+	sb.WriteString("\n\n***************************\n")
+	sb.WriteString("APPLICATION FAILED TO START\n")
+	sb.WriteString("***************************\n\n")
+	sb.WriteString("Description:\n\n")
+	sb.WriteString(analysis.Description)
+	sb.WriteString("\n")
+
+	if analysis.Action != "" {
+		sb.WriteString("\nAction:\n\n")
+		sb.WriteString(analysis.Action)
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,285 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+
+	"codnect.io/procyon/component"
+	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMissingPropertyFailureAnalyzer_Analyze(t *testing.T) {
+	testCases := []struct {
+		name            string
+		err             error
+		wantAnalysis    bool
+		wantDescription string
+		wantAction      string
+	}{
+		{
+			name: "unrelated error",
+			err:  errors.New("any error"),
+		},
+		{
+			name:            "missing property error",
+			err:             fmt.Errorf("bind property \"server\": %w", &config.MissingPropertyError{Name: "server.port"}),
+			wantAnalysis:    true,
+			wantDescription: "Required property 'server.port' is not set.\n\nReason: bind property \"server\": required",
+			wantAction: "Define 'server.port' in a configuration file, an environment variable or a command-line argument, " +
+				"or mark the property as optional or give it a default value.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			analyzer := newMissingPropertyFailureAnalyzer()
+
+			// when
+			analysis := analyzer.Analyze(tc.err)
+
+			// then
+			if !tc.wantAnalysis {
+				assert.Nil(t, analysis)
+				return
+			}
+
+			require.NotNil(t, analysis)
+			assert.Equal(t, tc.wantDescription, analysis.Description)
+			assert.Equal(t, tc.wantAction, analysis.Action)
+			assert.Equal(t, tc.err, analysis.Cause)
+		})
+	}
+}
+
+func TestInvalidPropertiesFailureAnalyzer_Analyze(t *testing.T) {
+	testCases := []struct {
+		name            string
+		err             error
+		wantAnalysis    bool
+		wantDescription string
+	}{
+		{
+			name: "unrelated error",
+			err:  errors.New("any error"),
+		},
+		{
+			name: "validation error",
+			err: fmt.Errorf("bind property \"server\": %w", &config.ValidationError{
+				Violations: []config.PropertyViolation{
+					{Name: "server.port", Value: 0, Origin: "config/procyon.yaml", Message: "must be at least 1"},
+					{Name: "server.mode", Value: "test", Message: "must be one of [debug, release]"},
+				},
+			}),
+			wantAnalysis: true,
+			wantDescription: "The following properties are not valid:\n\n" +
+				"    - server.port: must be at least 1, but was '0' (from config/procyon.yaml)\n" +
+				"    - server.mode: must be one of [debug, release], but was 'test'",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			analyzer := newInvalidPropertiesFailureAnalyzer()
+
+			// when
+			analysis := analyzer.Analyze(tc.err)
+
+			// then
+			if !tc.wantAnalysis {
+				assert.Nil(t, analysis)
+				return
+			}
+
+			require.NotNil(t, analysis)
+			assert.Equal(t, tc.wantDescription, analysis.Description)
+			assert.Equal(t, "Update the configuration so that the properties satisfy their constraints.", analysis.Action)
+			assert.Equal(t, tc.err, analysis.Cause)
+		})
+	}
+}
+
+func TestAmbiguousComponentFailureAnalyzer_Analyze(t *testing.T) {
+	testCases := []struct {
+		name            string
+		err             error
+		wantAnalysis    bool
+		wantDescription string
+	}{
+		{
+			name: "unrelated error",
+			err:  errors.New("any error"),
+		},
+		{
+			name:            "ambiguous match error",
+			err:             fmt.Errorf("resolve type procyon.AnyComponent: %w", component.ErrAmbiguousMatch),
+			wantAnalysis:    true,
+			wantDescription: "A dependency matched more than one component.\n\nReason: resolve type procyon.AnyComponent: ambiguous match",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			analyzer := newAmbiguousComponentFailureAnalyzer()
+
+			// when
+			analysis := analyzer.Analyze(tc.err)
+
+			// then
+			if !tc.wantAnalysis {
+				assert.Nil(t, analysis)
+				return
+			}
+
+			require.NotNil(t, analysis)
+			assert.Equal(t, tc.wantDescription, analysis.Description)
+			assert.NotEmpty(t, analysis.Action)
+			assert.Equal(t, tc.err, analysis.Cause)
+		})
+	}
+}
+
+func TestPortInUseFailureAnalyzer_Analyze(t *testing.T) {
+	testCases := []struct {
+		name         string
+		err          error
+		wantAnalysis bool
+	}{
+		{
+			name: "unrelated error",
+			err:  errors.New("any error"),
+		},
+		{
+			name: "address in use error",
+			err: &net.OpError{
+				Op:  "listen",
+				Net: "tcp",
+				Err: os.NewSyscallError("bind", syscall.EADDRINUSE),
+			},
+			wantAnalysis: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			analyzer := newPortInUseFailureAnalyzer()
+
+			// when
+			analysis := analyzer.Analyze(tc.err)
+
+			// then
+			if !tc.wantAnalysis {
+				assert.Nil(t, analysis)
+				return
+			}
+
+			require.NotNil(t, analysis)
+			assert.Contains(t, analysis.Description, "port is already in use")
+			assert.Contains(t, analysis.Action, "server.port")
+			assert.Equal(t, tc.err, analysis.Cause)
+		})
+	}
+}
+
+func TestUnresolvedPlaceholderFailureAnalyzer_Analyze(t *testing.T) {
+	testCases := []struct {
+		name            string
+		err             error
+		wantAnalysis    bool
+		wantDescription string
+	}{
+		{
+			name: "unrelated error",
+			err:  errors.New("any error"),
+		},
+		{
+			name:            "unresolved placeholder error",
+			err:             fmt.Errorf("expand \"${db.url}\": %w", &config.UnresolvedPlaceholderError{Name: "db.url"}),
+			wantAnalysis:    true,
+			wantDescription: "Placeholder '${db.url}' could not be resolved.\n\nReason: expand \"${db.url}\": unresolved placeholder ${db.url}",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			analyzer := newUnresolvedPlaceholderFailureAnalyzer()
+
+			// when
+			analysis := analyzer.Analyze(tc.err)
+
+			// then
+			if !tc.wantAnalysis {
+				assert.Nil(t, analysis)
+				return
+			}
+
+			require.NotNil(t, analysis)
+			assert.Equal(t, tc.wantDescription, analysis.Description)
+			assert.NotEmpty(t, analysis.Action)
+			assert.Equal(t, tc.err, analysis.Cause)
+		})
+	}
+}
+
+func TestFormatFailureAnalysis(t *testing.T) {
+	testCases := []struct {
+		name       string
+		analysis   *runtime.FailureAnalysis
+		wantReport string
+	}{
+		{
+			name: "with action",
+			analysis: &runtime.FailureAnalysis{
+				Description: "anyDescription",
+				Action:      "anyAction",
+			},
+			wantReport: "\n\n***************************\nAPPLICATION FAILED TO START\n***************************\n\n" +
+				"Description:\n\nanyDescription\n\nAction:\n\nanyAction\n",
+		},
+		{
+			name: "without action",
+			analysis: &runtime.FailureAnalysis{
+				Description: "anyDescription",
+			},
+			wantReport: "\n\n***************************\nAPPLICATION FAILED TO START\n***************************\n\n" +
+				"Description:\n\nanyDescription\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+
+			// when
+			report := formatFailureAnalysis(tc.analysis)
+
+			// then
+			assert.Equal(t, tc.wantReport, report)
+		})
+	}
+}
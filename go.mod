@@ -3,8 +3,8 @@ module codnect.io/procyon
 go 1.24.0
 
 require (
-	codnect.io/logy v1.0.2
 	codnect.io/tag v1.0.0
+	github.com/BurntSushi/toml v1.5.0
 	github.com/spf13/cobra v1.9.1
 	github.com/stretchr/testify v1.11.1
 	gopkg.in/yaml.v3 v3.0.1
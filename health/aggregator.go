@@ -0,0 +1,98 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"slices"
+	"strings"
+)
+
+var (
+	// defaultStatusOrder is the default status order, from the most to the least severe.
+	defaultStatusOrder = []Status{StatusDown, StatusOutOfService, StatusDegraded, StatusUp, StatusUnknown}
+)
+
+// HealthAggregator combines the statuses of several indicators into a single status.
+type HealthAggregator interface {
+	// Aggregate returns the status representing all the given statuses.
+	Aggregate(statuses ...Status) Status
+}
+
+// OrderedHealthAggregator is a HealthAggregator that picks the most severe status
+// based on a configurable status order.
+type OrderedHealthAggregator struct {
+	order []Status
+}
+
+// NewOrderedHealthAggregator creates a new OrderedHealthAggregator with the given status order,
+// from the most to the least severe. If no order is given, the default order
+// DOWN, OUT_OF_SERVICE, DEGRADED, UP, UNKNOWN is used.
+func NewOrderedHealthAggregator(order ...Status) *OrderedHealthAggregator {
+	if len(order) == 0 {
+		order = defaultStatusOrder
+	}
+
+	normalized := make([]Status, 0, len(order))
+	for _, status := range order {
+		normalized = append(normalized, normalizeStatus(status))
+	}
+
+	return &OrderedHealthAggregator{
+		order: normalized,
+	}
+}
+
+// newHealthAggregator creates the default HealthAggregator using the status order
+// configured in the given properties.
+func newHealthAggregator(props *HealthProperties) *OrderedHealthAggregator {
+	if props == nil {
+		panic("nil health properties")
+	}
+
+	order := make([]Status, 0, len(props.Order))
+	for _, status := range props.Order {
+		order = append(order, Status(status))
+	}
+
+	return NewOrderedHealthAggregator(order...)
+}
+
+// Aggregate returns the status that comes first in the configured order among the given statuses.
+// Statuses that are not part of the order are ignored. If none of the statuses is known,
+// it returns StatusUnknown.
+func (a *OrderedHealthAggregator) Aggregate(statuses ...Status) Status {
+	result := -1
+	for _, status := range statuses {
+		index := slices.Index(a.order, normalizeStatus(status))
+		if index == -1 {
+			continue
+		}
+
+		if result == -1 || index < result {
+			result = index
+		}
+	}
+
+	if result == -1 {
+		return StatusUnknown
+	}
+
+	return a.order[result]
+}
+
+// normalizeStatus converts the status to its canonical upper-case form.
+func normalizeStatus(status Status) Status {
+	return Status(strings.ToUpper(strings.TrimSpace(string(status))))
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHealthAggregator(t *testing.T) {
+	testCases := []struct {
+		name      string
+		props     *HealthProperties
+		wantOrder []Status
+		wantPanic error
+	}{
+		{
+			name:      "nil properties",
+			wantPanic: errors.New("nil health properties"),
+		},
+		{
+			name:      "empty order",
+			props:     &HealthProperties{},
+			wantOrder: defaultStatusOrder,
+		},
+		{
+			name:      "custom order",
+			props:     &HealthProperties{Order: []string{"down", " UP "}},
+			wantOrder: []Status{StatusDown, StatusUp},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+
+			// when
+			if tc.wantPanic != nil {
+				require.PanicsWithValue(t, tc.wantPanic.Error(), func() {
+					newHealthAggregator(tc.props)
+				})
+				return
+			}
+
+			aggregator := newHealthAggregator(tc.props)
+
+			// then
+			require.NotNil(t, aggregator)
+			assert.Equal(t, tc.wantOrder, aggregator.order)
+		})
+	}
+}
+
+func TestOrderedHealthAggregator_Aggregate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		order      []Status
+		statuses   []Status
+		wantStatus Status
+	}{
+		{
+			name:       "no statuses",
+			wantStatus: StatusUnknown,
+		},
+		{
+			name:       "all up",
+			statuses:   []Status{StatusUp, StatusUp},
+			wantStatus: StatusUp,
+		},
+		{
+			name:       "degraded and up",
+			statuses:   []Status{StatusUp, StatusDegraded},
+			wantStatus: StatusDegraded,
+		},
+		{
+			name:       "down wins",
+			statuses:   []Status{StatusOutOfService, StatusDown, StatusDegraded},
+			wantStatus: StatusDown,
+		},
+		{
+			name:       "unordered statuses are ignored",
+			statuses:   []Status{"CUSTOM", StatusUp},
+			wantStatus: StatusUp,
+		},
+		{
+			name:       "custom order",
+			order:      []Status{StatusUp, StatusDown},
+			statuses:   []Status{StatusDown, StatusUp},
+			wantStatus: StatusUp,
+		},
+		{
+			name:       "lower case status",
+			statuses:   []Status{"down", StatusUp},
+			wantStatus: StatusDown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			aggregator := NewOrderedHealthAggregator(tc.order...)
+
+			// when
+			status := aggregator.Aggregate(tc.statuses...)
+
+			// then
+			assert.Equal(t, tc.wantStatus, status)
+		})
+	}
+}
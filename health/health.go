@@ -0,0 +1,137 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Status represents the health status of a component or the whole application.
+type Status string
+
+const (
+	// StatusUp indicates that the component is functioning as expected.
+	StatusUp Status = "UP"
+	// StatusDegraded indicates that the component is functioning with reduced capabilities.
+	StatusDegraded Status = "DEGRADED"
+	// StatusOutOfService indicates that the component has been taken out of service deliberately.
+	StatusOutOfService Status = "OUT_OF_SERVICE"
+	// StatusDown indicates that the component is not functioning.
+	StatusDown Status = "DOWN"
+	// StatusUnknown indicates that the health of the component could not be determined.
+	StatusUnknown Status = "UNKNOWN"
+)
+
+// Health represents the health information reported by a HealthIndicator.
+type Health struct {
+	Status  Status         `json:"status"`
+	Details map[string]any `json:"details,omitempty"`
+}
+
+// Up returns a Health with status UP and the given details.
+func Up(details map[string]any) Health {
+	return Health{Status: StatusUp, Details: details}
+}
+
+// Down returns a Health with status DOWN. If err is not nil, it is added to the details
+// under the "error" key.
+func Down(err error) Health {
+	health := Health{Status: StatusDown}
+	if err != nil {
+		health.Details = map[string]any{"error": err.Error()}
+	}
+
+	return health
+}
+
+// CompositeHealth represents the aggregated health of multiple indicators.
+type CompositeHealth struct {
+	Status     Status            `json:"status"`
+	Components map[string]Health `json:"components,omitempty"`
+}
+
+// HealthIndicator is implemented by components that contribute health information.
+type HealthIndicator interface {
+	// Health returns the current health of the component.
+	Health(ctx context.Context) Health
+}
+
+// NamedHealthIndicator can be implemented by indicators that want to control the name
+// they are reported and grouped under.
+type NamedHealthIndicator interface {
+	HealthIndicator
+
+	// Name returns the name of the indicator.
+	Name() string
+}
+
+// unnamedIndicatorName is the prefix of the names of the indicators whose type has no name.
+const unnamedIndicatorName = "indicator"
+
+// indicatorNames returns the names of the given indicators. The indicators of unnamed types are named
+// "indicator" followed by their position among them, such as indicator1 and indicator2, in the order
+// of their types.
+func indicatorNames(indicators []HealthIndicator) []string {
+	names := make([]string, len(indicators))
+	unnamed := make([]int, 0)
+
+	for index, indicator := range indicators {
+		if names[index] = indicatorName(indicator); names[index] == "" {
+			unnamed = append(unnamed, index)
+		}
+	}
+
+	slices.SortStableFunc(unnamed, func(a, b int) int {
+		return strings.Compare(reflect.TypeOf(indicators[a]).String(), reflect.TypeOf(indicators[b]).String())
+	})
+
+	for position, index := range unnamed {
+		names[index] = unnamedIndicatorName + strconv.Itoa(position+1)
+	}
+
+	return names
+}
+
+// indicatorName returns the name of the given indicator. Indicators implementing NamedHealthIndicator
+// use their own name, others are named after their type without the "HealthIndicator" suffix. It returns
+// an empty string for the indicators of unnamed types, such as anonymous structs.
+func indicatorName(indicator HealthIndicator) string {
+	if named, ok := indicator.(NamedHealthIndicator); ok {
+		return named.Name()
+	}
+
+	typ := reflect.TypeOf(indicator)
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	name := strings.TrimSuffix(typ.Name(), "HealthIndicator")
+	if name == "" {
+		name = typ.Name()
+	}
+
+	if name == "" {
+		return ""
+	}
+
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type databaseHealthIndicator struct {
+	health Health
+}
+
+func (i *databaseHealthIndicator) Health(ctx context.Context) Health {
+	return i.health
+}
+
+type anyNamedHealthIndicator struct {
+	name   string
+	health Health
+}
+
+func (i *anyNamedHealthIndicator) Name() string {
+	return i.name
+}
+
+func (i *anyNamedHealthIndicator) Health(ctx context.Context) Health {
+	return i.health
+}
+
+type AnyIndicator struct {
+}
+
+func (i AnyIndicator) Health(ctx context.Context) Health {
+	return Up(nil)
+}
+
+func TestUp(t *testing.T) {
+	// given
+	details := map[string]any{"version": "1.0"}
+
+	// when
+	health := Up(details)
+
+	// then
+	assert.Equal(t, StatusUp, health.Status)
+	assert.Equal(t, details, health.Details)
+}
+
+func TestDown(t *testing.T) {
+	testCases := []struct {
+		name        string
+		err         error
+		wantDetails map[string]any
+	}{
+		{
+			name: "nil error",
+		},
+		{
+			name:        "with error",
+			err:         errors.New("connection refused"),
+			wantDetails: map[string]any{"error": "connection refused"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+
+			// when
+			health := Down(tc.err)
+
+			// then
+			assert.Equal(t, StatusDown, health.Status)
+			assert.Equal(t, tc.wantDetails, health.Details)
+		})
+	}
+}
+
+func TestIndicatorName(t *testing.T) {
+	testCases := []struct {
+		name      string
+		indicator HealthIndicator
+		wantName  string
+	}{
+		{
+			name:      "type with suffix",
+			indicator: &databaseHealthIndicator{},
+			wantName:  "database",
+		},
+		{
+			name:      "type without suffix",
+			indicator: AnyIndicator{},
+			wantName:  "anyIndicator",
+		},
+		{
+			name:      "named indicator",
+			indicator: &anyNamedHealthIndicator{name: "cache"},
+			wantName:  "cache",
+		},
+		{
+			name:      "unnamed type",
+			indicator: &struct{ AnyIndicator }{},
+			wantName:  "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+
+			// when
+			name := indicatorName(tc.indicator)
+
+			// then
+			assert.Equal(t, tc.wantName, name)
+		})
+	}
+}
+
+func TestIndicatorNames(t *testing.T) {
+	// given
+	indicators := []HealthIndicator{
+		&struct{ AnyIndicator }{},
+		&databaseHealthIndicator{},
+		struct{ AnyIndicator }{},
+		&struct{ AnyIndicator }{},
+	}
+
+	// when
+	names := indicatorNames(indicators)
+
+	// then
+	assert.Equal(t, []string{"indicator1", "database", "indicator3", "indicator2"}, names)
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+// HealthProperties defines the configuration properties of the health subsystem.
+type HealthProperties struct {
+	// Order is the status order used for aggregation, from the most to the least severe.
+	Order []string `property:"status.order,default=['DOWN','OUT_OF_SERVICE','DEGRADED','UP','UNKNOWN']"`
+	// TimeoutMillis is the default time in milliseconds an indicator is given to report its health.
+	TimeoutMillis int `property:"timeoutMillis,default=10000"`
+	// CacheTTLMillis is the default time in milliseconds an indicator result is cached. Zero disables caching.
+	CacheTTLMillis int `property:"cacheTtlMillis,optional"`
+	// Groups contains the health groups keyed by their name.
+	Groups map[string]GroupProperties `property:"group,optional"`
+	// Indicators contains indicator specific settings keyed by the indicator name.
+	Indicators map[string]IndicatorProperties `property:"indicator,optional"`
+}
+
+// GroupProperties defines which indicators belong to a health group.
+type GroupProperties struct {
+	// Include contains the names of the indicators in the group. If empty, all indicators are included.
+	Include []string `property:"include,optional"`
+	// Exclude contains the names of the indicators excluded from the group.
+	Exclude []string `property:"exclude,optional"`
+}
+
+// IndicatorProperties defines indicator specific settings overriding the defaults.
+type IndicatorProperties struct {
+	// TimeoutMillis is the time in milliseconds the indicator is given to report its health.
+	TimeoutMillis int `property:"timeoutMillis,optional"`
+	// CacheTTLMillis is the time in milliseconds the indicator result is cached.
+	CacheTTLMillis int `property:"cacheTtlMillis,optional"`
+}
+
+// newHealthProperties creates a new HealthProperties.
+func newHealthProperties() *HealthProperties {
+	return &HealthProperties{}
+}
+
+// Prefix returns the configuration property prefix.
+func (p *HealthProperties) Prefix() string {
+	return "management.health"
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"testing"
+
+	"codnect.io/procyon/runtime/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthProperties_Prefix(t *testing.T) {
+	// given
+	props := newHealthProperties()
+
+	// when
+	prefix := props.Prefix()
+
+	// then
+	assert.Equal(t, "management.health", prefix)
+}
+
+func TestHealthProperties_Bind(t *testing.T) {
+	// given
+	propSource := config.NewMapPropertySource("anyMapSource", map[string]any{
+		"management.health.group.readiness.include":    "db,cache",
+		"management.health.indicator.db.timeoutMillis": 500,
+	})
+
+	binder := config.NewDefaultPropertyBinder(config.NewPropertySources(propSource))
+	props := newHealthProperties()
+
+	// when
+	err := binder.Bind(props.Prefix(), props)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"DOWN", "OUT_OF_SERVICE", "DEGRADED", "UP", "UNKNOWN"}, props.Order)
+	assert.Equal(t, 10000, props.TimeoutMillis)
+	assert.Equal(t, 0, props.CacheTTLMillis)
+	assert.Equal(t, []string{"db", "cache"}, props.Groups["readiness"].Include)
+	assert.Equal(t, 500, props.Indicators["db"].TimeoutMillis)
+}
@@ -0,0 +1,238 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"codnect.io/procyon/component"
+)
+
+// cachedHealth holds a health result along with its expiration time.
+type cachedHealth struct {
+	health    Health
+	expiresAt time.Time
+}
+
+// HealthService checks the health of all HealthIndicator components in the container
+// and aggregates their statuses. Indicators are checked concurrently, each with its own
+// timeout, and their results can be cached for a configurable time.
+type HealthService struct {
+	container  component.Container
+	aggregator HealthAggregator
+	props      *HealthProperties
+
+	cache   map[string]cachedHealth
+	muCache sync.Mutex
+	now     func() time.Time
+}
+
+// NewHealthService creates a new HealthService with the given container, aggregator and properties.
+func NewHealthService(container component.Container, aggregator HealthAggregator, props *HealthProperties) *HealthService {
+	if container == nil {
+		panic("nil container")
+	}
+
+	if aggregator == nil {
+		panic("nil health aggregator")
+	}
+
+	if props == nil {
+		panic("nil health properties")
+	}
+
+	return &HealthService{
+		container:  container,
+		aggregator: aggregator,
+		props:      props,
+		cache:      make(map[string]cachedHealth),
+		now:        time.Now,
+	}
+}
+
+// Health checks all health indicators and returns their aggregated health.
+func (s *HealthService) Health(ctx context.Context) (CompositeHealth, error) {
+	if ctx == nil {
+		return CompositeHealth{}, errors.New("nil context")
+	}
+
+	return s.check(ctx, func(string) bool {
+		return true
+	})
+}
+
+// GroupHealth checks the health indicators belonging to the group with the given name and returns
+// their aggregated health. It returns an error if the group is not defined.
+func (s *HealthService) GroupHealth(ctx context.Context, group string) (CompositeHealth, error) {
+	if ctx == nil {
+		return CompositeHealth{}, errors.New("nil context")
+	}
+
+	groupProps, ok := s.props.Groups[group]
+	if !ok {
+		return CompositeHealth{}, fmt.Errorf("health group %q: %w", group, component.ErrNotFound)
+	}
+
+	return s.check(ctx, func(name string) bool {
+		if slices.Contains(groupProps.Exclude, name) {
+			return false
+		}
+
+		return len(groupProps.Include) == 0 || slices.Contains(groupProps.Include, name)
+	})
+}
+
+// Groups returns the sorted names of the configured health groups.
+func (s *HealthService) Groups() []string {
+	return slices.Sorted(maps.Keys(s.props.Groups))
+}
+
+// check resolves the health indicators accepted by the filter, checks them concurrently
+// and aggregates their results.
+func (s *HealthService) check(ctx context.Context, filter func(name string) bool) (CompositeHealth, error) {
+	indicators, err := component.ResolveAll[HealthIndicator](ctx, s.container)
+	if err != nil {
+		return CompositeHealth{}, fmt.Errorf("resolve health indicators: %w", err)
+	}
+
+	names := indicatorNames(indicators)
+
+	selected := make(map[string]HealthIndicator, len(indicators))
+	for index, indicator := range indicators {
+		name := names[index]
+		if _, dup := selected[name]; dup {
+			return CompositeHealth{}, fmt.Errorf("health indicator %q: duplicate indicator name", name)
+		}
+
+		if filter(name) {
+			selected[name] = indicator
+		}
+	}
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+
+	components := make(map[string]Health, len(selected))
+	for name, indicator := range selected {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			health := s.indicatorHealth(ctx, name, indicator)
+
+			mu.Lock()
+			components[name] = health
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	statuses := make([]Status, 0, len(components))
+	for _, health := range components {
+		statuses = append(statuses, health.Status)
+	}
+
+	return CompositeHealth{
+		Status:     s.aggregator.Aggregate(statuses...),
+		Components: components,
+	}, nil
+}
+
+// indicatorHealth returns the health of the given indicator, using the cached result
+// if it has not expired yet.
+func (s *HealthService) indicatorHealth(ctx context.Context, name string, indicator HealthIndicator) Health {
+	ttl := s.cacheTTL(name)
+
+	if ttl > 0 {
+		s.muCache.Lock()
+		cached, ok := s.cache[name]
+		s.muCache.Unlock()
+
+		if ok && s.now().Before(cached.expiresAt) {
+			return cached.health
+		}
+	}
+
+	health := s.invokeIndicator(ctx, indicator, s.timeout(name))
+
+	if ttl > 0 {
+		s.muCache.Lock()
+		s.cache[name] = cachedHealth{health: health, expiresAt: s.now().Add(ttl)}
+		s.muCache.Unlock()
+	}
+
+	return health
+}
+
+// invokeIndicator calls the indicator within the given timeout. Panics and timeouts
+// are reported as DOWN.
+func (s *HealthService) invokeIndicator(ctx context.Context, indicator HealthIndicator, timeout time.Duration) Health {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	done := make(chan Health, 1)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- Down(fmt.Errorf("health indicator panic: %v", r))
+			}
+		}()
+
+		done <- indicator.Health(ctx)
+	}()
+
+	select {
+	case health := <-done:
+		if health.Status == "" {
+			health.Status = StatusUnknown
+		}
+
+		return health
+	case <-ctx.Done():
+		return Down(fmt.Errorf("health check: %w", ctx.Err()))
+	}
+}
+
+// timeout returns the timeout of the indicator with the given name.
+func (s *HealthService) timeout(name string) time.Duration {
+	if indicatorProps, ok := s.props.Indicators[name]; ok && indicatorProps.TimeoutMillis > 0 {
+		return time.Duration(indicatorProps.TimeoutMillis) * time.Millisecond
+	}
+
+	return time.Duration(s.props.TimeoutMillis) * time.Millisecond
+}
+
+// cacheTTL returns the time the result of the indicator with the given name is cached.
+func (s *HealthService) cacheTTL(name string) time.Duration {
+	if indicatorProps, ok := s.props.Indicators[name]; ok && indicatorProps.CacheTTLMillis > 0 {
+		return time.Duration(indicatorProps.CacheTTLMillis) * time.Millisecond
+	}
+
+	return time.Duration(s.props.CacheTTLMillis) * time.Millisecond
+}
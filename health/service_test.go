@@ -0,0 +1,394 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"codnect.io/procyon/component"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type anyFuncHealthIndicator struct {
+	name  string
+	calls atomic.Int32
+	fn    func(ctx context.Context) Health
+}
+
+func (i *anyFuncHealthIndicator) Name() string {
+	return i.name
+}
+
+func (i *anyFuncHealthIndicator) Health(ctx context.Context) Health {
+	i.calls.Add(1)
+	return i.fn(ctx)
+}
+
+func newAnyFuncHealthIndicator(name string, fn func(ctx context.Context) Health) *anyFuncHealthIndicator {
+	return &anyFuncHealthIndicator{name: name, fn: fn}
+}
+
+func newAnyHealthService(t *testing.T, props *HealthProperties, indicators ...HealthIndicator) *HealthService {
+	container := component.NewStandardContainer()
+	for _, indicator := range indicators {
+		err := container.RegisterSingleton(indicatorName(indicator), indicator)
+		require.NoError(t, err)
+	}
+
+	return NewHealthService(container, NewOrderedHealthAggregator(), props)
+}
+
+func TestNewHealthService(t *testing.T) {
+	testCases := []struct {
+		name       string
+		container  component.Container
+		aggregator HealthAggregator
+		props      *HealthProperties
+		wantPanic  error
+	}{
+		{
+			name:       "nil container",
+			aggregator: NewOrderedHealthAggregator(),
+			props:      &HealthProperties{},
+			wantPanic:  errors.New("nil container"),
+		},
+		{
+			name:      "nil aggregator",
+			container: component.NewStandardContainer(),
+			props:     &HealthProperties{},
+			wantPanic: errors.New("nil health aggregator"),
+		},
+		{
+			name:       "nil properties",
+			container:  component.NewStandardContainer(),
+			aggregator: NewOrderedHealthAggregator(),
+			wantPanic:  errors.New("nil health properties"),
+		},
+		{
+			name:       "valid arguments",
+			container:  component.NewStandardContainer(),
+			aggregator: NewOrderedHealthAggregator(),
+			props:      &HealthProperties{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+
+			// when
+			if tc.wantPanic != nil {
+				require.PanicsWithValue(t, tc.wantPanic.Error(), func() {
+					NewHealthService(tc.container, tc.aggregator, tc.props)
+				})
+				return
+			}
+
+			service := NewHealthService(tc.container, tc.aggregator, tc.props)
+
+			// then
+			require.NotNil(t, service)
+		})
+	}
+}
+
+func TestHealthService_Health(t *testing.T) {
+	testCases := []struct {
+		name           string
+		ctx            context.Context
+		props          *HealthProperties
+		indicators     []HealthIndicator
+		wantErr        error
+		wantStatus     Status
+		wantComponents map[string]Health
+	}{
+		{
+			name:    "nil context",
+			ctx:     nil,
+			props:   &HealthProperties{},
+			wantErr: errors.New("nil context"),
+		},
+		{
+			name:           "no indicators",
+			ctx:            context.Background(),
+			props:          &HealthProperties{},
+			wantStatus:     StatusUnknown,
+			wantComponents: map[string]Health{},
+		},
+		{
+			name:  "aggregated status",
+			ctx:   context.Background(),
+			props: &HealthProperties{},
+			indicators: []HealthIndicator{
+				newAnyFuncHealthIndicator("db", func(ctx context.Context) Health {
+					return Up(nil)
+				}),
+				newAnyFuncHealthIndicator("cache", func(ctx context.Context) Health {
+					return Health{Status: StatusDegraded}
+				}),
+			},
+			wantStatus: StatusDegraded,
+			wantComponents: map[string]Health{
+				"db":    {Status: StatusUp},
+				"cache": {Status: StatusDegraded},
+			},
+		},
+		{
+			name:  "empty status",
+			ctx:   context.Background(),
+			props: &HealthProperties{},
+			indicators: []HealthIndicator{
+				newAnyFuncHealthIndicator("db", func(ctx context.Context) Health {
+					return Health{}
+				}),
+			},
+			wantStatus: StatusUnknown,
+			wantComponents: map[string]Health{
+				"db": {Status: StatusUnknown},
+			},
+		},
+		{
+			name:  "indicator panics",
+			ctx:   context.Background(),
+			props: &HealthProperties{},
+			indicators: []HealthIndicator{
+				newAnyFuncHealthIndicator("db", func(ctx context.Context) Health {
+					panic("connection lost")
+				}),
+			},
+			wantStatus: StatusDown,
+			wantComponents: map[string]Health{
+				"db": Down(errors.New("health indicator panic: connection lost")),
+			},
+		},
+		{
+			name: "indicator timeout",
+			ctx:  context.Background(),
+			props: &HealthProperties{
+				TimeoutMillis: 1000,
+				Indicators: map[string]IndicatorProperties{
+					"db": {TimeoutMillis: 10},
+				},
+			},
+			indicators: []HealthIndicator{
+				newAnyFuncHealthIndicator("db", func(ctx context.Context) Health {
+					<-ctx.Done()
+					time.Sleep(50 * time.Millisecond)
+					return Up(nil)
+				}),
+				newAnyFuncHealthIndicator("cache", func(ctx context.Context) Health {
+					return Up(nil)
+				}),
+			},
+			wantStatus: StatusDown,
+			wantComponents: map[string]Health{
+				"db":    Down(errors.New("health check: context deadline exceeded")),
+				"cache": {Status: StatusUp},
+			},
+		},
+		{
+			name:  "unnamed indicators",
+			ctx:   context.Background(),
+			props: &HealthProperties{},
+			indicators: []HealthIndicator{
+				&struct{ AnyIndicator }{},
+				&struct{ AnyIndicator }{},
+			},
+			wantStatus: StatusUp,
+			wantComponents: map[string]Health{
+				"indicator1": {Status: StatusUp},
+				"indicator2": {Status: StatusUp},
+			},
+		},
+		{
+			name:  "duplicate indicator name",
+			ctx:   context.Background(),
+			props: &HealthProperties{},
+			indicators: []HealthIndicator{
+				&anyNamedHealthIndicator{name: "database"},
+				&databaseHealthIndicator{},
+			},
+			wantErr: errors.New("health indicator \"database\": duplicate indicator name"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			container := component.NewStandardContainer()
+			for index, indicator := range tc.indicators {
+				err := container.RegisterSingleton(string(rune('a'+index)), indicator)
+				require.NoError(t, err)
+			}
+
+			service := NewHealthService(container, NewOrderedHealthAggregator(), tc.props)
+
+			// when
+			health, err := service.Health(tc.ctx)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, health.Status)
+			assert.Equal(t, tc.wantComponents, health.Components)
+		})
+	}
+}
+
+func TestHealthService_HealthCaching(t *testing.T) {
+	// given
+	indicator := newAnyFuncHealthIndicator("db", func(ctx context.Context) Health {
+		return Up(nil)
+	})
+
+	service := newAnyHealthService(t, &HealthProperties{CacheTTLMillis: 1000}, indicator)
+
+	now := time.Now()
+	service.now = func() time.Time {
+		return now
+	}
+
+	// when
+	_, err := service.Health(context.Background())
+	require.NoError(t, err)
+
+	now = now.Add(500 * time.Millisecond)
+	_, err = service.Health(context.Background())
+	require.NoError(t, err)
+
+	callsBeforeExpiry := indicator.calls.Load()
+
+	now = now.Add(time.Second)
+	_, err = service.Health(context.Background())
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, int32(1), callsBeforeExpiry)
+	assert.Equal(t, int32(2), indicator.calls.Load())
+}
+
+func TestHealthService_GroupHealth(t *testing.T) {
+	up := func(ctx context.Context) Health {
+		return Up(nil)
+	}
+
+	down := func(ctx context.Context) Health {
+		return Down(nil)
+	}
+
+	testCases := []struct {
+		name           string
+		ctx            context.Context
+		group          string
+		wantErr        error
+		wantStatus     Status
+		wantComponents []string
+	}{
+		{
+			name:    "nil context",
+			group:   "readiness",
+			wantErr: errors.New("nil context"),
+		},
+		{
+			name:    "group not found",
+			ctx:     context.Background(),
+			group:   "startup",
+			wantErr: errors.New("health group \"startup\": not found"),
+		},
+		{
+			name:           "included indicators",
+			ctx:            context.Background(),
+			group:          "readiness",
+			wantStatus:     StatusUp,
+			wantComponents: []string{"db", "cache"},
+		},
+		{
+			name:           "excluded indicators",
+			ctx:            context.Background(),
+			group:          "liveness",
+			wantStatus:     StatusUp,
+			wantComponents: []string{"db", "cache"},
+		},
+		{
+			name:           "all indicators",
+			ctx:            context.Background(),
+			group:          "all",
+			wantStatus:     StatusDown,
+			wantComponents: []string{"db", "cache", "mail"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			props := &HealthProperties{
+				Groups: map[string]GroupProperties{
+					"readiness": {Include: []string{"db", "cache"}},
+					"liveness":  {Exclude: []string{"mail"}},
+					"all":       {},
+				},
+			}
+
+			service := newAnyHealthService(t, props,
+				newAnyFuncHealthIndicator("db", up),
+				newAnyFuncHealthIndicator("cache", up),
+				newAnyFuncHealthIndicator("mail", down),
+			)
+
+			// when
+			health, err := service.GroupHealth(tc.ctx, tc.group)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, health.Status)
+			assert.Len(t, health.Components, len(tc.wantComponents))
+			for _, name := range tc.wantComponents {
+				assert.Contains(t, health.Components, name)
+			}
+		})
+	}
+}
+
+func TestHealthService_Groups(t *testing.T) {
+	// given
+	props := &HealthProperties{
+		Groups: map[string]GroupProperties{
+			"readiness": {},
+			"liveness":  {},
+		},
+	}
+
+	service := newAnyHealthService(t, props)
+
+	// when
+	groups := service.Groups()
+
+	// then
+	assert.Equal(t, []string{"liveness", "readiness"}, groups)
+}
@@ -22,8 +22,19 @@ import (
 func init() {
 	// runtime/config
 	component.Register(config.NewYamlPropertySourceLoader)
+	component.Register(config.NewPropertiesPropertySourceLoader)
+	component.Register(config.NewDotenvPropertySourceLoader)
+	component.Register(config.NewJsonPropertySourceLoader)
+	component.Register(config.NewTomlPropertySourceLoader)
 
 	// main
 	component.Register(newConfigEnvCustomizer)
 	component.Register(newConfigPropertiesProcessor)
+	component.Register(newMissingPropertyFailureAnalyzer)
+	component.Register(newInvalidPropertiesFailureAnalyzer)
+	component.Register(newAmbiguousComponentFailureAnalyzer)
+	component.Register(newPortInUseFailureAnalyzer)
+	component.Register(newUnresolvedPlaceholderFailureAnalyzer)
+	component.Register(newPidFileWriter)
+	component.Register(newAppInfoWriter)
 }
@@ -17,6 +17,7 @@ package procyon
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"reflect"
 	"sync"
 	"time"
@@ -70,7 +71,7 @@ func (d *defaultLifecycleManager) Startup(ctx context.Context) error {
 			return fmt.Errorf("start lifecycle component %q: %w", objectName, err)
 		}
 
-		log.Debug("Started lifecycle component '{}'", objectName)
+		slog.Debug("Started lifecycle component", "component", objectName)
 	}
 
 	d.running = true
@@ -101,10 +102,10 @@ func (d *defaultLifecycleManager) Shutdown(ctx context.Context) error {
 		select {
 		case err := <-done:
 			if err != nil {
-				log.Warn("Failed to stopLifecycleManager component '{}'", name, err)
+				slog.Warn("Failed to stop lifecycle component", "component", name, "error", err)
 			}
 
-			log.Debug("Stopped lifecycle component '{}'", name)
+			slog.Debug("Stopped lifecycle component", "component", name)
 		case <-shutdownCtx.Done():
 			d.running = false
 			return nil
@@ -0,0 +1,160 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"fmt"
+	"strings"
+
+	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
+)
+
+const (
+	// RootLogger is the name of the logger whose level applies to the loggers without a level.
+	RootLogger = "root"
+
+	// LevelPropPrefix is the prefix of the properties specifying the levels of the loggers, such as
+	// logging.level.root=warn or logging.level.codnect.io/procyon/http=debug. The prefix is matched in a
+	// relaxed way, so LOGGING_LEVEL_ROOT=warn specifies the level of the root logger as well.
+	LevelPropPrefix = "logging.level."
+	// FormatProp is the property key for specifying the format of the log records.
+	FormatProp = "logging.format"
+	// FilePathProp is the property key for specifying the file the log records are written to.
+	FilePathProp = "logging.file.path"
+	// ColorProp is the property key for specifying whether the log levels are colored.
+	ColorProp = "logging.color"
+)
+
+// Format represents the format of the log records.
+type Format string
+
+const (
+	// TextFormat writes the records as key=value pairs.
+	TextFormat Format = "text"
+	// JSONFormat writes the records as JSON objects.
+	JSONFormat Format = "json"
+)
+
+// ColorMode represents whether the log levels are colored.
+type ColorMode string
+
+const (
+	// ColorDetect colors the levels if the records are written to a terminal and the NO_COLOR
+	// environment variable is not set.
+	ColorDetect ColorMode = "detect"
+	// ColorAlways always colors the levels.
+	ColorAlways ColorMode = "always"
+	// ColorNever never colors the levels.
+	ColorNever ColorMode = "never"
+)
+
+// Config represents the configuration of a logging system.
+type Config struct {
+	// Levels contains the levels of the loggers by their names. The name of a logger is the import
+	// path of its package, and a level applies to the nested packages as well.
+	Levels map[string]Level
+	// Format is the format of the log records.
+	Format Format
+	// FilePath is the path of the file the records are written to. The records are written to the
+	// standard output if it is empty.
+	FilePath string
+	// Color specifies whether the levels are colored in the text format.
+	Color ColorMode
+}
+
+// ConfigFrom creates the logging configuration from the properties of the given environment.
+func ConfigFrom(env runtime.Environment) (Config, error) {
+	if env == nil {
+		return Config{}, fmt.Errorf("nil environment")
+	}
+
+	resolver := env.PropertyResolver()
+
+	cfg := Config{
+		Levels:   map[string]Level{},
+		Format:   Format(strings.ToLower(lookupString(env, FormatProp, string(TextFormat)))),
+		FilePath: lookupString(env, FilePathProp, ""),
+		Color:    ColorMode(strings.ToLower(lookupString(env, ColorProp, string(ColorDetect)))),
+	}
+
+	switch cfg.Format {
+	case TextFormat, JSONFormat:
+	default:
+		return Config{}, fmt.Errorf("invalid %s '%s'", FormatProp, cfg.Format)
+	}
+
+	switch cfg.Color {
+	case ColorDetect, ColorAlways, ColorNever:
+	default:
+		return Config{}, fmt.Errorf("invalid %s '%s'", ColorProp, cfg.Color)
+	}
+
+	levelPropName := config.ParsePropertyName(strings.TrimSuffix(LevelPropPrefix, "."))
+
+	for _, propSource := range env.PropertySources().Slice() {
+		for _, name := range propSource.PropertyNames() {
+			if !levelPropName.IsAncestorOf(config.ParsePropertyName(name)) {
+				continue
+			}
+
+			logger := levelLogger(name)
+			if logger == "" {
+				continue
+			}
+
+			if _, exists := cfg.Levels[logger]; exists {
+				continue
+			}
+
+			value, _ := resolver.Lookup(name)
+			level, err := ParseLevel(fmt.Sprint(value))
+			if err != nil {
+				return Config{}, fmt.Errorf("property %q: %w", name, err)
+			}
+
+			cfg.Levels[logger] = level
+		}
+	}
+
+	return cfg, nil
+}
+
+// levelLogger returns the name of the logger whose level the given property specifies, which follows the
+// logging.level prefix. The words of a name in the environment variable form are joined with dots, such
+// as com.example for LOGGING_LEVEL_COM_EXAMPLE.
+func levelLogger(name string) string {
+	if parts := strings.SplitN(name, ".", 3); len(parts) == 3 {
+		return parts[2]
+	}
+
+	words := strings.Split(strings.ToLower(name), "_")
+	if len(words) < 3 {
+		return ""
+	}
+
+	return strings.Join(words[2:], ".")
+}
+
+// lookupString returns the trimmed string value of the given property, or the default value if the
+// property does not exist.
+func lookupString(env runtime.Environment, name, defaultValue string) string {
+	value, ok := env.PropertyResolver().Lookup(name)
+	if !ok {
+		return defaultValue
+	}
+
+	return strings.TrimSpace(fmt.Sprint(value))
+}
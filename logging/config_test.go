@@ -0,0 +1,144 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"errors"
+	"testing"
+
+	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type anyEnvironment struct {
+	runtime.Environment
+	propSources *config.PropertySources
+}
+
+func newAnyEnvironment(sources ...config.PropertySource) *anyEnvironment {
+	return &anyEnvironment{propSources: config.NewPropertySources(sources...)}
+}
+
+func (e *anyEnvironment) PropertySources() *config.PropertySources {
+	return e.propSources
+}
+
+func (e *anyEnvironment) PropertyResolver() config.PropertyResolver {
+	return config.NewDefaultPropertyResolver(e.propSources)
+}
+
+func TestConfigFrom(t *testing.T) {
+	testCases := []struct {
+		name       string
+		env        runtime.Environment
+		wantConfig Config
+		wantErr    error
+	}{
+		{
+			name:    "nil environment",
+			wantErr: errors.New("nil environment"),
+		},
+		{
+			name: "defaults",
+			env:  newAnyEnvironment(),
+			wantConfig: Config{
+				Levels: map[string]Level{},
+				Format: TextFormat,
+				Color:  ColorDetect,
+			},
+		},
+		{
+			name: "properties",
+			env: newAnyEnvironment(
+				config.NewMapPropertySource("first", map[string]any{
+					"logging.level.codnect.io/procyon/http": "debug",
+				}),
+				config.NewMapPropertySource("second", map[string]any{
+					"logging.level.root":                    "warn",
+					"logging.level.codnect.io/procyon/http": "error",
+					"logging.format":                        "JSON",
+					"logging.file.path":                     "logs/app.log",
+					"logging.color":                         "never",
+				}),
+			),
+			wantConfig: Config{
+				Levels: map[string]Level{
+					"root":                    LevelWarn,
+					"codnect.io/procyon/http": LevelDebug,
+				},
+				Format:   JSONFormat,
+				FilePath: "logs/app.log",
+				Color:    ColorNever,
+			},
+		},
+		{
+			name: "levels in environment variable form",
+			env: newAnyEnvironment(
+				config.NewMapPropertySource("systemEnvironment", map[string]any{
+					"LOGGING_LEVEL_ROOT":        "debug",
+					"LOGGING_LEVEL_COM_EXAMPLE": "warn",
+					"LOGGING_LEVELS":            "error",
+				}),
+			),
+			wantConfig: Config{
+				Levels: map[string]Level{
+					"root":        LevelDebug,
+					"com.example": LevelWarn,
+				},
+				Format: TextFormat,
+				Color:  ColorDetect,
+			},
+		},
+		{
+			name: "invalid format",
+			env: newAnyEnvironment(config.NewMapPropertySource("any", map[string]any{
+				"logging.format": "xml",
+			})),
+			wantErr: errors.New("invalid logging.format 'xml'"),
+		},
+		{
+			name: "invalid color",
+			env: newAnyEnvironment(config.NewMapPropertySource("any", map[string]any{
+				"logging.color": "sometimes",
+			})),
+			wantErr: errors.New("invalid logging.color 'sometimes'"),
+		},
+		{
+			name: "invalid level",
+			env: newAnyEnvironment(config.NewMapPropertySource("any", map[string]any{
+				"logging.level.root": "verbose",
+			})),
+			wantErr: errors.New("property \"logging.level.root\": unknown level 'verbose'"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			cfg, err := ConfigFrom(tc.env)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantConfig, cfg)
+		})
+	}
+}
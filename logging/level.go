@@ -0,0 +1,82 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logging provides the logging system abstraction of the application. The logging system is
+// configured from the environment, and the levels of the loggers can be changed at runtime.
+package logging
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+)
+
+// Level represents the severity of a log record. The levels are compatible with slog.Level.
+type Level int
+
+const (
+	// LevelTrace is the level of fine-grained diagnostic records.
+	LevelTrace = Level(slog.LevelDebug - 4)
+	// LevelDebug is the level of diagnostic records.
+	LevelDebug = Level(slog.LevelDebug)
+	// LevelInfo is the level of informational records.
+	LevelInfo = Level(slog.LevelInfo)
+	// LevelWarn is the level of records about potential problems.
+	LevelWarn = Level(slog.LevelWarn)
+	// LevelError is the level of records about failures.
+	LevelError = Level(slog.LevelError)
+	// LevelOff disables logging.
+	LevelOff = Level(math.MaxInt32)
+)
+
+// levelNames contains the names of the levels.
+var levelNames = map[Level]string{
+	LevelTrace: "TRACE",
+	LevelDebug: "DEBUG",
+	LevelInfo:  "INFO",
+	LevelWarn:  "WARN",
+	LevelError: "ERROR",
+	LevelOff:   "OFF",
+}
+
+// ParseLevel parses the given level name, case-insensitively.
+func ParseLevel(name string) (Level, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(name))
+	if normalized == "WARNING" {
+		normalized = "WARN"
+	}
+
+	for level, levelName := range levelNames {
+		if levelName == normalized {
+			return level, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown level '%s'", name)
+}
+
+// String returns the name of the level.
+func (l Level) String() string {
+	if name, ok := levelNames[l]; ok {
+		return name
+	}
+
+	return slog.Level(l).String()
+}
+
+// Level returns the slog.Level corresponding to the level.
+func (l Level) Level() slog.Level {
+	return slog.Level(l)
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLevel(t *testing.T) {
+	testCases := []struct {
+		name      string
+		value     string
+		wantLevel Level
+		wantErr   error
+	}{
+		{name: "trace", value: "trace", wantLevel: LevelTrace},
+		{name: "debug", value: "DEBUG", wantLevel: LevelDebug},
+		{name: "info", value: " Info ", wantLevel: LevelInfo},
+		{name: "warn", value: "warn", wantLevel: LevelWarn},
+		{name: "warning", value: "warning", wantLevel: LevelWarn},
+		{name: "error", value: "error", wantLevel: LevelError},
+		{name: "off", value: "off", wantLevel: LevelOff},
+		{name: "unknown", value: "verbose", wantErr: errors.New("unknown level 'verbose'")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			level, err := ParseLevel(tc.value)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantLevel, level)
+		})
+	}
+}
+
+func TestLevel_String(t *testing.T) {
+	assert.Equal(t, "TRACE", LevelTrace.String())
+	assert.Equal(t, "OFF", LevelOff.String())
+	assert.Equal(t, "INFO+2", Level(slog.LevelInfo+2).String())
+	assert.Equal(t, slog.LevelWarn, LevelWarn.Level())
+}
@@ -0,0 +1,353 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// levelColors contains the ANSI escape codes the levels are colored with.
+var levelColors = map[Level]string{
+	LevelTrace: "\033[90m",
+	LevelDebug: "\033[36m",
+	LevelInfo:  "\033[32m",
+	LevelWarn:  "\033[33m",
+	LevelError: "\033[31m",
+}
+
+// HandlerFunc creates the slog handler writing the records to the given writer with the given options.
+// The options filter the records by the minimum level of the loggers, and name and color the levels.
+type HandlerFunc func(w io.Writer, opts *slog.HandlerOptions) slog.Handler
+
+// SlogLoggingSystem is the default LoggingSystem, which configures the default logger of log/slog that the
+// framework logs with as well. The records are filtered by the level of the package they are logged from.
+type SlogLoggingSystem struct {
+	handlerFunc HandlerFunc
+	stdout      io.Writer
+
+	levels   map[string]Level
+	file     *os.File
+	previous *slog.Logger
+	mu       sync.RWMutex
+	// the lowest level of the loggers, below which the records are not handled by any logger
+	minLevel atomic.Int64
+}
+
+// NewSlogLoggingSystem creates a new slog logging system writing to the standard output by default.
+func NewSlogLoggingSystem() *SlogLoggingSystem {
+	system := &SlogLoggingSystem{
+		stdout: os.Stdout,
+		levels: map[string]Level{},
+	}
+
+	system.updateMinLevel()
+	return system
+}
+
+// SetHandlerFunc sets the function creating the slog handler, which replaces the text and JSON handlers
+// selected by the format.
+func (s *SlogLoggingSystem) SetHandlerFunc(fn HandlerFunc) {
+	if fn == nil {
+		panic("nil handler func")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlerFunc = fn
+}
+
+// Initialize opens the log file if any, and sets the default slog logger to a logger writing the records
+// in the configured format. It can be called again to apply a new configuration.
+func (s *SlogLoggingSystem) Initialize(config Config) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var (
+		writer io.Writer = s.stdout
+		file   *os.File
+	)
+
+	if config.FilePath != "" {
+		var err error
+		file, err = openLogFile(config.FilePath)
+		if err != nil {
+			return err
+		}
+
+		writer = file
+	}
+
+	levels := make(map[string]Level, len(config.Levels))
+	for logger, level := range config.Levels {
+		levels[logger] = level
+	}
+
+	colors := config.Color == ColorAlways ||
+		config.Color == ColorDetect && file == nil && isTerminal(writer) && os.Getenv("NO_COLOR") == ""
+
+	opts := &slog.HandlerOptions{
+		Level: slog.Level(LevelTrace),
+		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+			if len(groups) == 0 && attr.Key == slog.LevelKey {
+				return slog.String(slog.LevelKey, formatLevel(attr.Value, colors && config.Format != JSONFormat))
+			}
+
+			return attr
+		},
+	}
+
+	var handler slog.Handler
+	switch {
+	case s.handlerFunc != nil:
+		handler = s.handlerFunc(writer, opts)
+	case config.Format == JSONFormat:
+		handler = slog.NewJSONHandler(writer, opts)
+	default:
+		handler = slog.NewTextHandler(writer, opts)
+	}
+
+	if s.previous == nil {
+		s.previous = slog.Default()
+	}
+
+	s.closeFile()
+	s.file = file
+	s.levels = levels
+	s.updateMinLevel()
+
+	slog.SetDefault(slog.New(&levelHandler{handler: handler, system: s}))
+	return nil
+}
+
+// SetLevel changes the level of the logger with the given name.
+func (s *SlogLoggingSystem) SetLevel(logger string, level Level) error {
+	if strings.TrimSpace(logger) == "" {
+		return errors.New("empty logger name")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.levels[logger] = level
+	s.updateMinLevel()
+	return nil
+}
+
+// Level returns the level of the logger with the given name, which is the level of the closest logger
+// it is nested in, the root level or the info level, in this order.
+func (s *SlogLoggingSystem) Level(logger string) Level {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.level(logger)
+}
+
+// Levels returns the levels of the loggers that have a level.
+func (s *SlogLoggingSystem) Levels() map[string]Level {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	levels := make(map[string]Level, len(s.levels))
+	for logger, level := range s.levels {
+		levels[logger] = level
+	}
+
+	return levels
+}
+
+// Cleanup restores the default slog logger that was in place before the initialization and closes the
+// log file.
+func (s *SlogLoggingSystem) Cleanup() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.previous != nil {
+		slog.SetDefault(s.previous)
+		s.previous = nil
+	}
+
+	return s.closeFile()
+}
+
+// level returns the effective level of the given logger. Caller must hold s.mu.
+func (s *SlogLoggingSystem) level(logger string) Level {
+	for name := logger; name != ""; name = parentLogger(name) {
+		if level, ok := s.levels[name]; ok {
+			return level
+		}
+	}
+
+	if level, ok := s.levels[RootLogger]; ok {
+		return level
+	}
+
+	return LevelInfo
+}
+
+// updateMinLevel updates the lowest level of the loggers, which is the lowest of the level of the loggers
+// without a level and the levels of the loggers. Caller must hold s.mu.
+func (s *SlogLoggingSystem) updateMinLevel() {
+	minLevel := s.level("")
+	for _, level := range s.levels {
+		minLevel = min(minLevel, level)
+	}
+
+	s.minLevel.Store(int64(minLevel))
+}
+
+// closeFile closes the current log file if any. Caller must hold s.mu.
+func (s *SlogLoggingSystem) closeFile() error {
+	if s.file == nil {
+		return nil
+	}
+
+	err := s.file.Close()
+	s.file = nil
+
+	if err != nil {
+		return fmt.Errorf("close log file: %w", err)
+	}
+
+	return nil
+}
+
+// levelHandler filters the records by the level of the package they are logged from.
+type levelHandler struct {
+	handler slog.Handler
+	system  *SlogLoggingSystem
+}
+
+// Enabled reports whether any record of the given level can be handled, that is whether the level is not
+// below the lowest level of the loggers. The level of the package is checked by Handle.
+func (h *levelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	if Level(level) < Level(h.system.minLevel.Load()) {
+		return false
+	}
+
+	return h.handler.Enabled(ctx, level)
+}
+
+// Handle handles the record if its level is enabled for the package it is logged from.
+func (h *levelHandler) Handle(ctx context.Context, record slog.Record) error {
+	if Level(record.Level) < h.system.Level(packageOf(record.PC)) {
+		return nil
+	}
+
+	return h.handler.Handle(ctx, record)
+}
+
+// WithAttrs returns a handler with the given attributes.
+func (h *levelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &levelHandler{handler: h.handler.WithAttrs(attrs), system: h.system}
+}
+
+// WithGroup returns a handler with the given group.
+func (h *levelHandler) WithGroup(name string) slog.Handler {
+	return &levelHandler{handler: h.handler.WithGroup(name), system: h.system}
+}
+
+// packageOf returns the import path of the package of the function at the given program counter.
+func packageOf(pc uintptr) string {
+	if pc == 0 {
+		return ""
+	}
+
+	frame, _ := goruntime.CallersFrames([]uintptr{pc}).Next()
+	return packageName(frame.Function)
+}
+
+// packageName returns the import path of the package of the given function name. A function name is the
+// package path followed by a dot and the function, such as codnect.io/procyon/http.(*Server).Start, where
+// the dots of the last element of the path are escaped, such as gopkg.in/yaml%2ev3.(*Decoder).Decode.
+func packageName(function string) string {
+	name := function
+	if bracket := strings.Index(name, "["); bracket != -1 {
+		name = name[:bracket]
+	}
+
+	lastSlash := strings.LastIndex(name, "/")
+	if dot := strings.Index(name[lastSlash+1:], "."); dot != -1 {
+		name = name[:lastSlash+1+dot]
+	}
+
+	if unescaped, err := url.PathUnescape(name); err == nil {
+		return unescaped
+	}
+
+	return name
+}
+
+// parentLogger returns the name of the logger the given logger is nested in, or an empty string.
+func parentLogger(logger string) string {
+	index := strings.LastIndexAny(logger, "/.")
+	if index == -1 {
+		return ""
+	}
+
+	return logger[:index]
+}
+
+// formatLevel returns the name of the given level, colored if requested.
+func formatLevel(value slog.Value, colors bool) string {
+	level, ok := value.Any().(slog.Level)
+	if !ok {
+		return value.String()
+	}
+
+	name := Level(level).String()
+	if color, ok := levelColors[Level(level)]; ok && colors {
+		return color + name + "\033[0m"
+	}
+
+	return name
+}
+
+// openLogFile opens the given log file for appending, creating it and its directories if needed.
+func openLogFile(path string) (*os.File, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("open log file %q: %w", path, err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open log file %q: %w", path, err)
+	}
+
+	return file, nil
+}
+
+// isTerminal reports whether the given writer is a terminal.
+func isTerminal(w io.Writer) bool {
+	file, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,367 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAnySlogLoggingSystem(t *testing.T) (*SlogLoggingSystem, *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	system := NewSlogLoggingSystem()
+	system.stdout = buf
+
+	t.Cleanup(func() {
+		require.NoError(t, system.Cleanup())
+	})
+
+	return system, buf
+}
+
+func TestSlogLoggingSystem_SetHandlerFunc(t *testing.T) {
+	assert.PanicsWithValue(t, "nil handler func", func() {
+		NewSlogLoggingSystem().SetHandlerFunc(nil)
+	})
+}
+
+func TestSlogLoggingSystem_Initialize(t *testing.T) {
+	testCases := []struct {
+		name       string
+		config     Config
+		log        func()
+		wantOutput []string
+	}{
+		{
+			name:   "default level",
+			config: Config{Format: TextFormat},
+			log: func() {
+				slog.Debug("debug message")
+				slog.Info("info message")
+			},
+			wantOutput: []string{"level=INFO msg=\"info message\""},
+		},
+		{
+			name: "root level",
+			config: Config{
+				Format: TextFormat,
+				Levels: map[string]Level{RootLogger: LevelWarn},
+			},
+			log: func() {
+				slog.Info("info message")
+				slog.Warn("warn message")
+			},
+			wantOutput: []string{"level=WARN msg=\"warn message\""},
+		},
+		{
+			name: "package level",
+			config: Config{
+				Format: TextFormat,
+				Levels: map[string]Level{
+					RootLogger:                 LevelError,
+					"codnect.io/procyon":       LevelInfo,
+					"codnect.io/procyon/other": LevelOff,
+				},
+			},
+			log: func() {
+				slog.Log(nil, slog.Level(LevelTrace), "trace message")
+				slog.Info("info message")
+			},
+			wantOutput: []string{"level=INFO msg=\"info message\""},
+		},
+		{
+			name: "trace level",
+			config: Config{
+				Format: TextFormat,
+				Levels: map[string]Level{"codnect.io/procyon/logging": LevelTrace},
+			},
+			log: func() {
+				slog.Log(nil, slog.Level(LevelTrace), "trace message")
+			},
+			wantOutput: []string{"level=TRACE msg=\"trace message\""},
+		},
+		{
+			name: "off level",
+			config: Config{
+				Format: TextFormat,
+				Levels: map[string]Level{"codnect.io/procyon/logging": LevelOff},
+			},
+			log: func() {
+				slog.Error("error message")
+			},
+		},
+		{
+			name:   "colors",
+			config: Config{Format: TextFormat, Color: ColorAlways},
+			log: func() {
+				slog.Info("info message")
+			},
+			wantOutput: []string{"level=\"\\x1b[32mINFO\\x1b[0m\" msg=\"info message\""},
+		},
+		{
+			name:   "colors detected",
+			config: Config{Format: TextFormat, Color: ColorDetect},
+			log: func() {
+				slog.Info("info message")
+			},
+			wantOutput: []string{"level=INFO msg=\"info message\""},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			system, buf := newAnySlogLoggingSystem(t)
+
+			// when
+			err := system.Initialize(tc.config)
+			tc.log()
+
+			// then
+			require.NoError(t, err)
+
+			lines := make([]string, 0)
+			for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+				if line == "" {
+					continue
+				}
+
+				_, message, _ := strings.Cut(line, " ")
+				lines = append(lines, message)
+			}
+
+			assert.Equal(t, len(tc.wantOutput), len(lines), buf.String())
+			for index, want := range tc.wantOutput {
+				assert.Equal(t, want, lines[index])
+			}
+		})
+	}
+}
+
+func TestSlogLoggingSystem_InitializeJSON(t *testing.T) {
+	// given
+	system, buf := newAnySlogLoggingSystem(t)
+
+	// when
+	err := system.Initialize(Config{Format: JSONFormat, Color: ColorAlways})
+	slog.Info("info message", "key", "value")
+
+	// then
+	require.NoError(t, err)
+
+	record := map[string]any{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "INFO", record["level"])
+	assert.Equal(t, "info message", record["msg"])
+	assert.Equal(t, "value", record["key"])
+}
+
+func TestSlogLoggingSystem_InitializeFile(t *testing.T) {
+	// given
+	path := filepath.Join(t.TempDir(), "logs", "app.log")
+	system, buf := newAnySlogLoggingSystem(t)
+
+	// when
+	err := system.Initialize(Config{Format: TextFormat, FilePath: path})
+	slog.Info("info message")
+
+	// then
+	require.NoError(t, err)
+	require.NoError(t, system.Cleanup())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "level=INFO msg=\"info message\"")
+	assert.Empty(t, buf.String())
+}
+
+func TestSlogLoggingSystem_InitializeFileError(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o644))
+
+	system, _ := newAnySlogLoggingSystem(t)
+	previous := slog.Default()
+
+	// when
+	err := system.Initialize(Config{FilePath: filepath.Join(file, "app.log")})
+
+	// then
+	require.ErrorContains(t, err, "open log file")
+	assert.Same(t, previous, slog.Default())
+}
+
+func TestSlogLoggingSystem_HandlerFunc(t *testing.T) {
+	// given
+	system, _ := newAnySlogLoggingSystem(t)
+
+	var out bytes.Buffer
+	system.SetHandlerFunc(func(w io.Writer, opts *slog.HandlerOptions) slog.Handler {
+		return slog.NewJSONHandler(&out, opts)
+	})
+
+	// when
+	err := system.Initialize(Config{Format: TextFormat})
+	slog.Info("info message")
+
+	// then
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), "\"msg\":\"info message\"")
+}
+
+func TestSlogLoggingSystem_SetLevel(t *testing.T) {
+	// given
+	system, buf := newAnySlogLoggingSystem(t)
+	require.NoError(t, system.Initialize(Config{Format: TextFormat}))
+
+	// when
+	err := system.SetLevel("codnect.io/procyon/logging", LevelDebug)
+	slog.Debug("debug message")
+
+	// then
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "level=DEBUG msg=\"debug message\"")
+	assert.Equal(t, LevelDebug, system.Level("codnect.io/procyon/logging"))
+	assert.Equal(t, LevelInfo, system.Level("codnect.io/procyon/http"))
+	assert.Equal(t, map[string]Level{"codnect.io/procyon/logging": LevelDebug}, system.Levels())
+	assert.Equal(t, errors.New("empty logger name"), system.SetLevel(" ", LevelDebug))
+}
+
+func TestSlogLoggingSystem_Enabled(t *testing.T) {
+	testCases := []struct {
+		name        string
+		levels      map[string]Level
+		setLevel    map[string]Level
+		level       slog.Level
+		wantEnabled bool
+	}{
+		{
+			name:        "info level by default",
+			level:       slog.LevelInfo,
+			wantEnabled: true,
+		},
+		{
+			name:  "debug level below default level",
+			level: slog.LevelDebug,
+		},
+		{
+			name:   "info level below root level",
+			levels: map[string]Level{RootLogger: LevelWarn},
+			level:  slog.LevelInfo,
+		},
+		{
+			name:        "debug level of a package",
+			levels:      map[string]Level{RootLogger: LevelWarn, "codnect.io/procyon/http": LevelDebug},
+			level:       slog.LevelDebug,
+			wantEnabled: true,
+		},
+		{
+			name:        "debug level set at runtime",
+			setLevel:    map[string]Level{"codnect.io/procyon/http": LevelDebug},
+			level:       slog.LevelDebug,
+			wantEnabled: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			system, _ := newAnySlogLoggingSystem(t)
+			require.NoError(t, system.Initialize(Config{Format: TextFormat, Levels: tc.levels}))
+
+			for logger, level := range tc.setLevel {
+				require.NoError(t, system.SetLevel(logger, level))
+			}
+
+			// when
+			enabled := slog.Default().Enabled(context.Background(), tc.level)
+
+			// then
+			assert.Equal(t, tc.wantEnabled, enabled)
+		})
+	}
+}
+
+func TestSlogLoggingSystem_Cleanup(t *testing.T) {
+	// given
+	previous := slog.Default()
+	system, _ := newAnySlogLoggingSystem(t)
+	require.NoError(t, system.Initialize(Config{Format: TextFormat}))
+	require.NotSame(t, previous, slog.Default())
+
+	// when
+	err := system.Cleanup()
+
+	// then
+	require.NoError(t, err)
+	assert.Same(t, previous, slog.Default())
+}
+
+func TestPackageOf(t *testing.T) {
+	// given
+	pcs := make([]uintptr, 1)
+	goruntime.Callers(1, pcs)
+
+	// when
+	pkg := packageOf(pcs[0])
+
+	// then
+	assert.Equal(t, "codnect.io/procyon/logging", pkg)
+	assert.Equal(t, "", packageOf(0))
+}
+
+func TestPackageName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		function string
+		wantName string
+	}{
+		{name: "method", function: "codnect.io/procyon/http.(*Server).Start", wantName: "codnect.io/procyon/http"},
+		{name: "function", function: "codnect.io/procyon.New", wantName: "codnect.io/procyon"},
+		{name: "closure", function: "codnect.io/procyon/http.(*Server).Start.func1", wantName: "codnect.io/procyon/http"},
+		{name: "generic function", function: "codnect.io/procyon/component.Resolve[...]", wantName: "codnect.io/procyon/component"},
+		{name: "dotted module path", function: "gopkg.in/yaml%2ev3.(*decoder).unmarshal", wantName: "gopkg.in/yaml.v3"},
+		{name: "main package", function: "main.main", wantName: "main"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			name := packageName(tc.function)
+
+			// then
+			assert.Equal(t, tc.wantName, name)
+		})
+	}
+}
+
+func TestParentLogger(t *testing.T) {
+	assert.Equal(t, "codnect.io/procyon", parentLogger("codnect.io/procyon/http"))
+	assert.Equal(t, "com.example", parentLogger("com.example.app"))
+	assert.Equal(t, "", parentLogger("root"))
+}
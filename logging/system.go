@@ -0,0 +1,32 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+// LoggingSystem configures the logging of the application. The application initializes it right after
+// the environment is prepared, and registers it in the container, so that the levels can be changed at
+// runtime, for instance by a management endpoint.
+type LoggingSystem interface {
+	// Initialize configures the logging with the given configuration.
+	Initialize(config Config) error
+	// SetLevel changes the level of the logger with the given name. RootLogger changes the level of
+	// the loggers without a level.
+	SetLevel(logger string, level Level) error
+	// Level returns the effective level of the logger with the given name.
+	Level(logger string) Level
+	// Levels returns the levels of the loggers that have a level.
+	Levels() map[string]Level
+	// Cleanup releases the resources of the logging system, such as the log file.
+	Cleanup() error
+}
@@ -17,7 +17,10 @@ package procyon
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	stdio "io"
+	"reflect"
 
 	"codnect.io/procyon/io"
 	"codnect.io/procyon/runtime"
@@ -214,3 +217,57 @@ func (a *AnyMockServerApp) Port() int {
 	results := a.Called()
 	return results.Int(0)
 }
+
+type anyOrderedCommandLineRunner struct {
+	name  string
+	order int
+	calls *[]string
+}
+
+func (r *anyOrderedCommandLineRunner) Order() int {
+	return r.order
+}
+
+func (r *anyOrderedCommandLineRunner) Run(ctx runtime.Context, args *runtime.Args) error {
+	*r.calls = append(*r.calls, r.name)
+	return nil
+}
+
+type anyCommandRunner struct {
+	anyOrderedCommandLineRunner
+	command string
+}
+
+func (r *anyCommandRunner) Command() string {
+	return r.command
+}
+
+type anyFuncCommandLineRunner struct {
+	fn func()
+}
+
+func (r *anyFuncCommandLineRunner) Run(ctx runtime.Context, args *runtime.Args) error {
+	r.fn()
+	return nil
+}
+
+type anyEventListener struct {
+	name   string
+	order  int
+	events *[]string
+	errFor runtime.Event
+}
+
+func (l *anyEventListener) Order() int {
+	return l.order
+}
+
+func (l *anyEventListener) OnEvent(ctx context.Context, event runtime.Event) error {
+	*l.events = append(*l.events, fmt.Sprintf("%s %T", l.name, event))
+
+	if l.errFor != nil && reflect.TypeOf(l.errFor) == reflect.TypeOf(event) {
+		return errors.New("listener error")
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"codnect.io/procyon/runtime"
+)
+
+const (
+	// PidFileProp is the property key for specifying the file the process id is written to.
+	PidFileProp = "procyon.pidfile"
+)
+
+// pidFileWriter writes the process id to the file given by the procyon.pidfile property once the
+// application context has been refreshed, and removes the file when the context is closed.
+type pidFileWriter struct {
+	path string
+	mu   sync.Mutex
+}
+
+// newPidFileWriter creates a new pidFileWriter.
+func newPidFileWriter() *pidFileWriter {
+	return &pidFileWriter{}
+}
+
+// OnEvent writes the pid file on a ContextRefreshedEvent and removes it on a ContextClosedEvent. The
+// events of the child contexts are ignored.
+func (w *pidFileWriter) OnEvent(_ context.Context, event runtime.Event) error {
+	switch e := event.(type) {
+	case *runtime.ContextRefreshedEvent:
+		if !isRootContext(e.Context()) {
+			return nil
+		}
+
+		path := lookupString(e.Context().Environment(), PidFileProp, "")
+		if path == "" {
+			return nil
+		}
+
+		return w.write(path)
+	case *runtime.ContextClosedEvent:
+		if !isRootContext(e.Context()) {
+			return nil
+		}
+
+		w.remove()
+	}
+
+	return nil
+}
+
+// write writes the process id to the file at the given path.
+func (w *pidFileWriter) write(path string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	content := []byte(strconv.Itoa(os.Getpid()) + "\n")
+	if err := writeFile(path, content); err != nil {
+		return fmt.Errorf("write pid file %q: %w", path, err)
+	}
+
+	w.path = path
+	slog.Debug("Wrote pid file", "path", path)
+	return nil
+}
+
+// remove removes the written pid file, if any.
+func (w *pidFileWriter) remove() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.path == "" {
+		return
+	}
+
+	removeFile(w.path)
+	w.path = ""
+}
+
+// isRootContext reports whether the given context is not a child context.
+func isRootContext(ctx runtime.Context) bool {
+	c, ok := ctx.(*Context)
+	return !ok || c.parent == nil
+}
+
+// writeFile writes the given content to the file at the given path, creating the missing directories.
+// The content is written to a temporary file first, so that the file is never seen partially written.
+func writeFile(path string, content []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	_, err = tmp.Write(content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0o644)
+	}
+
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	return nil
+}
+
+// removeFile removes the file at the given path. As the files are removed on close, a failure
+// is only logged.
+func removeFile(path string) {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		slog.Warn("Failed to remove file", "path", path, "error", err)
+		return
+	}
+
+	slog.Debug("Removed file", "path", path)
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPidFileWriter(t *testing.T) {
+	// given
+	path := filepath.Join(t.TempDir(), "run", "app.pid")
+	app := NewBuilder().WithoutServer().Build()
+
+	// when
+	runtimeCtx, err := app.Start("--procyon.pidfile=" + path)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, runtimeCtx)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, strconv.Itoa(os.Getpid())+"\n", string(content))
+
+	child, err := NewChild(runtimeCtx).Run()
+	require.NoError(t, err)
+	require.NoError(t, child.Close(context.Background()))
+	assert.FileExists(t, path)
+
+	require.NoError(t, app.Shutdown(context.Background()))
+	assert.NoFileExists(t, path)
+}
+
+func TestPidFileWriter_WriteError(t *testing.T) {
+	// given
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o644))
+
+	path := filepath.Join(file, "app.pid")
+	app := NewBuilder().WithoutServer().Build()
+
+	// when
+	runtimeCtx, err := app.Start("--procyon.pidfile=" + path)
+
+	// then
+	assert.Nil(t, runtimeCtx)
+	require.ErrorContains(t, err, "write pid file \""+path+"\"")
+	assert.Equal(t, 1, app.ExitCode())
+}
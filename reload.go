@@ -0,0 +1,256 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"codnect.io/procyon/component"
+	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
+)
+
+// Reload prepares the environment again, which re-runs the environment customizers and so loads the
+// config data into fresh property sources, and compares the resulting properties with the current ones.
+// Nothing happens if no property has changed.
+//
+// Otherwise, the ReloadAware components are consulted first. One of them can veto the reload, in which
+// case an error wrapping runtime.ErrReloadVetoed is returned, or request a restart. The context is also
+// restarted if the active profiles have changed, as the conditions of the components depend on them.
+// If no restart is needed, the property sources of the environment are replaced, the refreshable
+// properties affected by the changes are bound again and a PropertiesChangedEvent is published.
+//
+// A reload waits for the reload or restart in progress, if any, to complete.
+func (c *Context) Reload(ctx context.Context) error {
+	if ctx == nil {
+		return &contextError{Op: "reload", Err: errors.New("nil context")}
+	}
+
+	c.lifecycleMu.Lock()
+	defer c.lifecycleMu.Unlock()
+
+	if err := c.doReload(ctx); err != nil {
+		return &contextError{Op: "reload", Err: err}
+	}
+
+	return nil
+}
+
+// doReload loads the properties again and applies the changes in place or by restarting the context.
+func (c *Context) doReload(ctx context.Context) error {
+	if !c.isActive() {
+		return errors.New("context not refreshed or closed")
+	}
+
+	if c.envProvider == nil {
+		return errors.New("no environment provider")
+	}
+
+	env, err := c.envProvider()
+	if err != nil {
+		return fmt.Errorf("prepare environment: %w", err)
+	}
+
+	current := c.Environment()
+
+	changedKeys := diffProperties(current, env)
+	if len(changedKeys) == 0 {
+		slog.Debug("No properties changed on reload")
+		return nil
+	}
+
+	restart, err := c.consultReloadAware(ctx, changedKeys)
+	if err != nil {
+		return err
+	}
+
+	if restart || !equalProfiles(current.ActiveProfiles(), env.ActiveProfiles()) {
+		slog.Info("Restarting application context to apply the changed properties", "properties", changedKeys)
+		return c.restart(ctx, env)
+	}
+
+	current.PropertySources().ReplaceAll(env.PropertySources().Slice()...)
+
+	if err = c.rebindProperties(ctx, changedKeys); err != nil {
+		return err
+	}
+
+	slog.Info("Reloaded properties", "properties", changedKeys)
+	return c.PublishEvent(ctx, runtime.NewPropertiesChangedEvent(c, changedKeys))
+}
+
+// consultReloadAware asks the ReloadAware components, in their order, whether the changes can be applied.
+// It returns an error if one of them vetoes the reload, and whether one of them requested a restart.
+func (c *Context) consultReloadAware(ctx context.Context, changedKeys []string) (bool, error) {
+	components, err := component.ResolveAll[runtime.ReloadAware](ctx, c.Container())
+	if err != nil {
+		return false, fmt.Errorf("resolve reload aware components: %w", err)
+	}
+
+	component.SortByOrder(components)
+
+	restart := false
+	for _, comp := range components {
+		switch comp.BeforeReload(ctx, slices.Clone(changedKeys)) {
+		case runtime.ReloadVeto:
+			return false, fmt.Errorf("%w by %T", runtime.ErrReloadVetoed, comp)
+		case runtime.ReloadRestart:
+			restart = true
+		}
+	}
+
+	return restart, nil
+}
+
+// rebindProperties binds the refreshable properties affected by the changed keys again. The properties are
+// bound into new instances first, so that none of the instances is modified if binding fails. As the new
+// instances start from their zero values, the properties removed from the sources do not keep their values.
+//
+// Only the property fields are then copied to the instances, the other fields such as their dependencies
+// are kept. The fields are copied while holding the lock of the instances implementing sync.Locker.
+func (c *Context) rebindProperties(ctx context.Context, changedKeys []string) error {
+	instances, err := component.ResolveAll[config.RefreshableProperties](ctx, c.Container())
+	if err != nil {
+		return fmt.Errorf("resolve refreshable properties: %w", err)
+	}
+
+	converters, err := component.ResolveAll[config.Converter](ctx, c.Container())
+	if err != nil {
+		return fmt.Errorf("resolve converters: %w", err)
+	}
+
+	binder := config.NewDefaultPropertyBinder(c.Environment().PropertySources(), converters...)
+
+	targets := make([]config.RefreshableProperties, 0, len(instances))
+	bound := make([]reflect.Value, 0, len(instances))
+
+	for _, instance := range instances {
+		if !instance.Refreshable() || !isAffectedPrefix(instance.Prefix(), changedKeys) {
+			continue
+		}
+
+		target := reflect.ValueOf(instance)
+		if target.Kind() != reflect.Pointer || target.IsNil() {
+			return fmt.Errorf("rebind properties %T: not a pointer", instance)
+		}
+
+		fresh := reflect.New(target.Elem().Type())
+		if err = binder.Bind(instance.Prefix(), fresh.Interface()); err != nil {
+			return fmt.Errorf("rebind properties %T: %w", instance, err)
+		}
+
+		targets = append(targets, instance)
+		bound = append(bound, fresh.Elem())
+	}
+
+	for index, target := range targets {
+		publishProperties(target, bound[index])
+	}
+
+	return nil
+}
+
+// publishProperties copies the property fields of the bound struct to the given properties, while holding
+// their lock if they implement sync.Locker.
+func publishProperties(props config.RefreshableProperties, bound reflect.Value) {
+	if locker, ok := props.(sync.Locker); ok {
+		locker.Lock()
+		defer locker.Unlock()
+	}
+
+	copyPropertyFields(reflect.ValueOf(props).Elem(), bound)
+}
+
+// copyPropertyFields copies the fields the binder binds, which are the tagged fields and the ones of the
+// untagged embedded structs, from the source struct to the target struct.
+func copyPropertyFields(target, source reflect.Value) {
+	targetType := target.Type()
+
+	for i := 0; i < targetType.NumField(); i++ {
+		field := targetType.Field(i)
+		fieldVal := target.Field(i)
+
+		if !fieldVal.CanSet() {
+			continue
+		}
+
+		if len(field.Tag) == 0 {
+			if field.Anonymous && fieldVal.Kind() == reflect.Struct {
+				copyPropertyFields(fieldVal, source.Field(i))
+			}
+
+			continue
+		}
+
+		fieldVal.Set(source.Field(i))
+	}
+}
+
+// diffProperties returns the sorted names of the properties whose raw values differ between the given
+// environments, including the properties that exist only in one of them. The raw values are compared, as
+// placeholders like ${random.uuid} resolve to a new value on each lookup.
+func diffProperties(current, next runtime.Environment) []string {
+	names := make(map[string]struct{})
+	for _, env := range []runtime.Environment{current, next} {
+		for _, propSource := range env.PropertySources().Slice() {
+			for _, name := range propSource.PropertyNames() {
+				names[name] = struct{}{}
+			}
+		}
+	}
+
+	currentResolver := config.NewDefaultPropertyResolver(current.PropertySources())
+	nextResolver := config.NewDefaultPropertyResolver(next.PropertySources())
+
+	changed := make([]string, 0)
+	for name := range names {
+		currentValue, currentOk := currentResolver.LookupRaw(name)
+		nextValue, nextOk := nextResolver.LookupRaw(name)
+
+		if currentOk != nextOk || !reflect.DeepEqual(currentValue, nextValue) {
+			changed = append(changed, name)
+		}
+	}
+
+	slices.Sort(changed)
+	return changed
+}
+
+// isAffectedPrefix reports whether any of the given keys belongs to the given prefix.
+func isAffectedPrefix(prefix string, keys []string) bool {
+	if prefix == "" {
+		return len(keys) != 0
+	}
+
+	for _, key := range keys {
+		if key == prefix || strings.HasPrefix(key, prefix+".") || strings.HasPrefix(key, prefix+"[") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// equalProfiles reports whether the given profiles are the same regardless of their order.
+func equalProfiles(profiles, others []string) bool {
+	return slices.Equal(slices.Sorted(slices.Values(profiles)), slices.Sorted(slices.Values(others)))
+}
@@ -0,0 +1,445 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procyon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"codnect.io/procyon/component"
+	"codnect.io/procyon/io"
+	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type anyRefreshableProperties struct {
+	Name   string `property:"name,optional"`
+	Port   int    `property:"port,default=8080"`
+	Client *anyClient
+	locks  int
+}
+
+type anyClient struct{}
+
+func (p *anyRefreshableProperties) Lock() {
+	p.locks++
+}
+
+func (p *anyRefreshableProperties) Unlock() {
+}
+
+func (p *anyRefreshableProperties) Prefix() string {
+	return "app"
+}
+
+func (p *anyRefreshableProperties) Refreshable() bool {
+	return true
+}
+
+type anyFixedProperties struct {
+	anyRefreshableProperties
+}
+
+func (p *anyFixedProperties) Refreshable() bool {
+	return false
+}
+
+type anyReloadAware struct {
+	decision runtime.ReloadDecision
+	keys     []string
+}
+
+func (r *anyReloadAware) BeforeReload(ctx context.Context, changedKeys []string) runtime.ReloadDecision {
+	r.keys = changedKeys
+	return r.decision
+}
+
+func newAnyReloadEnvironment(t *testing.T, props map[string]any, profiles ...string) *Environment {
+	env := NewEnvironment()
+	env.PropertySources().PushBack(config.NewMapPropertySource("appProps", props))
+	require.NoError(t, env.SetActiveProfiles(profiles...))
+	return env
+}
+
+func TestContext_Reload(t *testing.T) {
+	initialProps := map[string]any{
+		"app.name": "anyName",
+		"app.port": 9090,
+	}
+
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		refresh      bool
+		envProvider  func(t *testing.T) func() (runtime.Environment, error)
+		decisions    []runtime.ReloadDecision
+		wantErr      error
+		wantVetoed   bool
+		wantEvents   []string
+		wantKeys     []string
+		wantName     string
+		wantPort     int
+		wantFixed    string
+		wantLocks    int
+		wantRestart  bool
+		wantProperty any
+		wantLoads    int
+	}{
+		{
+			name:    "nil context",
+			wantErr: errors.New("reload context: nil context"),
+		},
+		{
+			name:    "non-refreshed context",
+			ctx:     context.Background(),
+			wantErr: errors.New("reload context: context not refreshed or closed"),
+		},
+		{
+			name:    "no environment provider",
+			ctx:     context.Background(),
+			refresh: true,
+			wantErr: errors.New("reload context: no environment provider"),
+		},
+		{
+			name:    "environment provider error",
+			ctx:     context.Background(),
+			refresh: true,
+			envProvider: func(t *testing.T) func() (runtime.Environment, error) {
+				return func() (runtime.Environment, error) {
+					return nil, errors.New("provider error")
+				}
+			},
+			wantErr: errors.New("reload context: prepare environment: provider error"),
+		},
+		{
+			name:    "no changes",
+			ctx:     context.Background(),
+			refresh: true,
+			envProvider: func(t *testing.T) func() (runtime.Environment, error) {
+				return func() (runtime.Environment, error) {
+					return newAnyReloadEnvironment(t, initialProps), nil
+				}
+			},
+			wantEvents:   []string{},
+			wantName:     "anyName",
+			wantPort:     9090,
+			wantFixed:    "anyName",
+			wantProperty: "anyName",
+		},
+		{
+			name:    "changes applied",
+			ctx:     context.Background(),
+			refresh: true,
+			envProvider: func(t *testing.T) func() (runtime.Environment, error) {
+				return func() (runtime.Environment, error) {
+					return newAnyReloadEnvironment(t, map[string]any{
+						"app.name":  "anotherName",
+						"app.owner": "anyOwner",
+					}), nil
+				}
+			},
+			decisions: []runtime.ReloadDecision{runtime.ReloadAccept},
+			wantEvents: []string{
+				"anyListener *runtime.PropertiesChangedEvent",
+			},
+			wantKeys:     []string{"app.name", "app.owner", "app.port"},
+			wantName:     "anotherName",
+			wantPort:     8080,
+			wantFixed:    "anyName",
+			wantLocks:    1,
+			wantProperty: "anotherName",
+		},
+		{
+			name:    "removed optional property",
+			ctx:     context.Background(),
+			refresh: true,
+			envProvider: func(t *testing.T) func() (runtime.Environment, error) {
+				return func() (runtime.Environment, error) {
+					return newAnyReloadEnvironment(t, map[string]any{"app.port": 7070}), nil
+				}
+			},
+			wantEvents: []string{
+				"anyListener *runtime.PropertiesChangedEvent",
+			},
+			wantName:  "",
+			wantPort:  7070,
+			wantFixed: "anyName",
+			wantLocks: 1,
+		},
+		{
+			name:    "reload vetoed",
+			ctx:     context.Background(),
+			refresh: true,
+			envProvider: func(t *testing.T) func() (runtime.Environment, error) {
+				return func() (runtime.Environment, error) {
+					return newAnyReloadEnvironment(t, map[string]any{"app.name": "anotherName"}), nil
+				}
+			},
+			decisions:    []runtime.ReloadDecision{runtime.ReloadRestart, runtime.ReloadVeto},
+			wantVetoed:   true,
+			wantEvents:   []string{},
+			wantKeys:     []string{"app.name", "app.port"},
+			wantName:     "anyName",
+			wantPort:     9090,
+			wantFixed:    "anyName",
+			wantProperty: "anyName",
+		},
+		{
+			name:    "restart requested",
+			ctx:     context.Background(),
+			refresh: true,
+			envProvider: func(t *testing.T) func() (runtime.Environment, error) {
+				return func() (runtime.Environment, error) {
+					return newAnyReloadEnvironment(t, map[string]any{"app.name": "anotherName"}), nil
+				}
+			},
+			decisions: []runtime.ReloadDecision{runtime.ReloadAccept, runtime.ReloadRestart},
+			wantEvents: []string{
+				"anyListener *runtime.ContextRestartingEvent",
+				"anyListener *runtime.ContextClosedEvent",
+				"anyListener *runtime.ContextRefreshedEvent",
+				"anyListener *runtime.ContextRestartedEvent",
+			},
+			wantKeys:     []string{"app.name", "app.port"},
+			wantRestart:  true,
+			wantProperty: "anotherName",
+			wantLoads:    1,
+		},
+		{
+			name:    "active profiles changed",
+			ctx:     context.Background(),
+			refresh: true,
+			envProvider: func(t *testing.T) func() (runtime.Environment, error) {
+				return func() (runtime.Environment, error) {
+					return newAnyReloadEnvironment(t, map[string]any{"app.name": "anotherName"}, "prod"), nil
+				}
+			},
+			wantEvents: []string{
+				"anyListener *runtime.ContextRestartingEvent",
+				"anyListener *runtime.ContextClosedEvent",
+				"anyListener *runtime.ContextRefreshedEvent",
+				"anyListener *runtime.ContextRestartedEvent",
+			},
+			wantRestart:  true,
+			wantProperty: "anotherName",
+			wantLoads:    1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			events := make([]string, 0)
+			startupContainer := component.NewStandardContainer()
+
+			client := &anyClient{}
+			refreshable := &anyRefreshableProperties{Name: "anyName", Port: 9090, Client: client}
+			fixed := &anyFixedProperties{anyRefreshableProperties{Name: "anyName", Port: 9090}}
+			require.NoError(t, startupContainer.RegisterSingleton("refreshableProps", refreshable))
+			require.NoError(t, startupContainer.RegisterSingleton("fixedProps", fixed))
+			require.NoError(t, startupContainer.RegisterSingleton("anyListener", &anyEventListener{
+				name:   "anyListener",
+				events: &events,
+			}))
+
+			reloadAware := make([]*anyReloadAware, 0)
+			for index, decision := range tc.decisions {
+				aware := &anyReloadAware{decision: decision}
+				reloadAware = append(reloadAware, aware)
+				require.NoError(t, startupContainer.RegisterSingleton(fmt.Sprintf("reloadAware%d", index), aware))
+			}
+
+			env := newAnyReloadEnvironment(t, initialProps)
+			ctx := createContext(env, startupContainer, io.NewDefaultResourceResolver())
+			loads := 0
+			if tc.envProvider != nil {
+				envProvider := tc.envProvider(t)
+				ctx.envProvider = func() (runtime.Environment, error) {
+					loads++
+					return envProvider()
+				}
+			}
+
+			if tc.refresh {
+				require.NoError(t, ctx.Refresh(context.Background()))
+				events = events[:0]
+			}
+
+			// when
+			err := ctx.Reload(tc.ctx)
+
+			// then
+			switch {
+			case tc.wantVetoed:
+				assert.ErrorIs(t, err, runtime.ErrReloadVetoed)
+			case tc.wantErr != nil:
+				assert.EqualError(t, err, tc.wantErr.Error())
+				return
+			default:
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.wantEvents, events)
+
+			for _, aware := range reloadAware {
+				if aware.keys != nil {
+					assert.Equal(t, tc.wantKeys, aware.keys)
+				}
+			}
+
+			value, _ := ctx.Environment().PropertyResolver().Lookup("app.name")
+			assert.Equal(t, tc.wantProperty, value)
+
+			if tc.wantRestart {
+				assert.NotSame(t, env, ctx.Environment())
+				assert.Equal(t, tc.wantLoads, loads)
+				return
+			}
+
+			assert.Same(t, env, ctx.Environment())
+			assert.Equal(t, tc.wantName, refreshable.Name)
+			assert.Equal(t, tc.wantPort, refreshable.Port)
+			assert.Equal(t, tc.wantFixed, fixed.Name)
+			assert.Same(t, client, refreshable.Client)
+			assert.Equal(t, tc.wantLocks, refreshable.locks)
+		})
+	}
+}
+
+func TestContext_ReloadSerializesRestart(t *testing.T) {
+	// given
+	ctx := createContext(newAnyReloadEnvironment(t, map[string]any{"app.name": "anyName"}),
+		component.NewStandardContainer(), io.NewDefaultResourceResolver())
+	require.NoError(t, ctx.Refresh(context.Background()))
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	var loads atomic.Int32
+
+	ctx.envProvider = func() (runtime.Environment, error) {
+		if loads.Add(1) == 1 {
+			close(entered)
+			<-release
+		}
+
+		return newAnyReloadEnvironment(t, map[string]any{"app.name": "anotherName"}), nil
+	}
+
+	reloadErr := make(chan error, 1)
+	go func() {
+		reloadErr <- ctx.Reload(context.Background())
+	}()
+	<-entered
+
+	// when
+	restartErr := make(chan error, 1)
+	go func() {
+		restartErr <- ctx.Restart(context.Background())
+	}()
+
+	// then
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, int32(1), loads.Load())
+
+	close(release)
+	require.NoError(t, <-reloadErr)
+	require.NoError(t, <-restartErr)
+	assert.Equal(t, int32(2), loads.Load())
+}
+
+func TestDiffProperties(t *testing.T) {
+	testCases := []struct {
+		name        string
+		current     map[string]any
+		next        map[string]any
+		wantChanged []string
+	}{
+		{
+			name: "changed and added properties",
+			current: map[string]any{
+				"app.name":  "anyName",
+				"app.port":  8080,
+				"app.hosts": []any{"a", "b"},
+			},
+			next: map[string]any{
+				"app.name":  "anyName",
+				"app.port":  9090,
+				"app.hosts": []any{"a", "b"},
+				"app.owner": "anyOwner",
+			},
+			wantChanged: []string{"app.owner", "app.port"},
+		},
+		{
+			name: "random value placeholders",
+			current: map[string]any{
+				"app.id":   "${random.uuid}",
+				"app.seed": "${random.int(10,100)}",
+			},
+			next: map[string]any{
+				"app.id":   "${random.uuid}",
+				"app.seed": "${random.int(10,100)}",
+			},
+			wantChanged: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			current := newAnyReloadEnvironment(t, tc.current)
+			current.PropertySources().PushBack(config.NewRandomValuePropertySource())
+			next := newAnyReloadEnvironment(t, tc.next)
+			next.PropertySources().PushBack(config.NewRandomValuePropertySource())
+
+			// when
+			changed := diffProperties(current, next)
+
+			// then
+			assert.Equal(t, tc.wantChanged, changed)
+		})
+	}
+}
+
+func TestIsAffectedPrefix(t *testing.T) {
+	testCases := []struct {
+		name   string
+		prefix string
+		keys   []string
+		want   bool
+	}{
+		{name: "empty prefix", prefix: "", keys: []string{"any"}, want: true},
+		{name: "exact key", prefix: "app", keys: []string{"app"}, want: true},
+		{name: "nested key", prefix: "app", keys: []string{"app.name"}, want: true},
+		{name: "indexed key", prefix: "app", keys: []string{"app[0]"}, want: true},
+		{name: "similar prefix", prefix: "app", keys: []string{"application.name"}, want: false},
+		{name: "no keys", prefix: "app", want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			affected := isAffectedPrefix(tc.prefix, tc.keys)
+
+			// then
+			assert.Equal(t, tc.want, affected)
+		})
+	}
+}
@@ -15,47 +15,201 @@
 package runtime
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
 	"strings"
+	"unicode"
+
+	"codnect.io/procyon/runtime/config"
 )
 
 const (
 	// NonOptionArgs represents the non-option arguments.
 	NonOptionArgs = "nonOptionArgs"
+
+	// argsBindingPrefix is the prefix used to bind arguments to a target struct.
+	argsBindingPrefix = "args"
 )
 
+// negativeNumberPattern matches the negative decimal numbers, which are taken as values rather than
+// options. Unlike strconv.ParseFloat, it does not match -inf, -nan or the hexadecimal numbers.
+var negativeNumberPattern = regexp.MustCompile(`^-(\d+(\.\d*)?|\.\d+)([eE][-+]?\d+)?$`)
+
 // Args struct represents the command line arguments passed to the application.
 type Args struct {
-	optArgs     map[string][]string
-	nonOptsArgs []string
+	optArgs      map[string][]string
+	optPositions map[string]int
+	nonOptsArgs  []string
+	position     int
+	valueOpts    map[string]struct{}
+	flagOpts     map[string]struct{}
 }
 
-// ParseArgs function parses the given and the command line arguments and returns an Args.
-func ParseArgs(args []string) (*Args, error) {
+// ParseArgs function parses the given command line arguments in POSIX/GNU style and returns an Args.
+//
+// The following forms are supported:
+//
+//	--name=value   option with a value
+//	--name value   option with a space-separated value, if the option is one of the given value options
+//	--name         boolean option set to "true"
+//	--no-name      boolean option set to "false"
+//	-abc           cluster of short boolean options
+//	-p=value       short option with a value, the last option of a cluster can also take a value
+//	-p value       short option with a space-separated value, if the option is one of the given value options
+//	--             terminates the options, all following arguments are non-option arguments
+//
+// Only the given value options take the next argument as their value, as a boolean option followed by
+// a command such as --verbose migrate cannot be told apart from an option with a value otherwise. The
+// value options require a value. Negative decimal numbers such as -5 or -1.5e3 and a single "-" are treated
+// as values rather than options. Repeated options collect all of their values, except the boolean options
+// given as flags such as --name, --no-name and -n, where the last of them wins.
+func ParseArgs(args []string, valueOptions ...string) (*Args, error) {
 	cmdLineArgs := &Args{
-		optArgs:     make(map[string][]string),
-		nonOptsArgs: make([]string, 0),
+		optArgs:      make(map[string][]string),
+		optPositions: make(map[string]int),
+		nonOptsArgs:  make([]string, 0),
+		valueOpts:    make(map[string]struct{}, len(valueOptions)),
+		flagOpts:     make(map[string]struct{}),
 	}
 
-	for _, arg := range args {
-		if strings.HasPrefix(arg, "--") {
-			indexOfEqualSign := strings.Index(arg, "=")
+	for _, name := range valueOptions {
+		cmdLineArgs.valueOpts[name] = struct{}{}
+	}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		cmdLineArgs.position = i + 1
+
+		var (
+			consumed int
+			err      error
+		)
 
-			if indexOfEqualSign == -1 {
-				return nil, fmt.Errorf("wrong argument format '%s'", arg)
-			} else {
-				cmdLineArgs.addOptionArgs(arg[2:indexOfEqualSign], arg[indexOfEqualSign+1:])
+		switch {
+		case arg == "--":
+			for _, nonOptArg := range args[i+1:] {
+				cmdLineArgs.addNonOptionArgs(nonOptArg)
 			}
 
-		} else {
+			return cmdLineArgs, nil
+		case strings.HasPrefix(arg, "--"):
+			consumed, err = cmdLineArgs.parseLongOption(arg, args[i+1:])
+		case strings.HasPrefix(arg, "-") && !isOptionValue(arg):
+			consumed, err = cmdLineArgs.parseShortOptions(arg, args[i+1:])
+		default:
 			cmdLineArgs.addNonOptionArgs(arg)
 		}
 
+		if err != nil {
+			return nil, fmt.Errorf("wrong argument format '%s': %w", arg, err)
+		}
+
+		i += consumed
 	}
 
 	return cmdLineArgs, nil
 }
 
+// parseLongOption method parses a long option such as --name=value, --name value, --name or --no-name.
+// It returns the number of following arguments consumed as the option value.
+func (a *Args) parseLongOption(arg string, next []string) (int, error) {
+	name, value, hasValue := strings.Cut(arg[2:], "=")
+
+	if err := validateOptionName(name); err != nil {
+		return 0, err
+	}
+
+	if hasValue {
+		a.addOptionArgs(name, value)
+		return 0, nil
+	}
+
+	if negated, ok := strings.CutPrefix(name, "no-"); ok {
+		if err := validateOptionName(negated); err != nil {
+			return 0, err
+		}
+
+		a.addFlagOption(negated, "false")
+		return 0, nil
+	}
+
+	return a.addOptionWithNextValue(name, next)
+}
+
+// parseShortOptions method parses a short option or a cluster of short options such as -v, -abc,
+// -p value or -p=value. It returns the number of following arguments consumed as the option value.
+func (a *Args) parseShortOptions(arg string, next []string) (int, error) {
+	names, value, hasValue := strings.Cut(arg[1:], "=")
+
+	if names == "" {
+		return 0, errors.New("empty option name")
+	}
+
+	flags := []rune(names)
+	for _, flag := range flags {
+		if !unicode.IsLetter(flag) && !unicode.IsDigit(flag) {
+			return 0, fmt.Errorf("invalid short option '%c'", flag)
+		}
+	}
+
+	for _, flag := range flags[:len(flags)-1] {
+		a.addFlagOption(string(flag), "true")
+	}
+
+	last := string(flags[len(flags)-1])
+
+	if hasValue {
+		a.addOptionArgs(last, value)
+		return 0, nil
+	}
+
+	return a.addOptionWithNextValue(last, next)
+}
+
+// addOptionWithNextValue method adds a value option with the next argument as its value, which must
+// not be an option itself. Other options are added as boolean options set to "true".
+// It returns the number of following arguments consumed.
+func (a *Args) addOptionWithNextValue(name string, next []string) (int, error) {
+	if _, ok := a.valueOpts[name]; !ok {
+		a.addFlagOption(name, "true")
+		return 0, nil
+	}
+
+	if len(next) == 0 || !isOptionValue(next[0]) {
+		return 0, fmt.Errorf("option '%s' requires a value", name)
+	}
+
+	a.addOptionArgs(name, next[0])
+	return 1, nil
+}
+
+// validateOptionName validates the name of a long option.
+func validateOptionName(name string) error {
+	if name == "" {
+		return errors.New("empty option name")
+	}
+
+	if strings.HasPrefix(name, "-") {
+		return fmt.Errorf("invalid option name '%s'", name)
+	}
+
+	if strings.IndexFunc(name, unicode.IsSpace) != -1 {
+		return fmt.Errorf("invalid option name '%s': contains whitespace", name)
+	}
+
+	return nil
+}
+
+// isOptionValue reports whether the given argument can be used as a value rather than an option.
+func isOptionValue(arg string) bool {
+	if !strings.HasPrefix(arg, "-") || arg == "-" {
+		return true
+	}
+
+	return negativeNumberPattern.MatchString(arg)
+}
+
 // OptionNames method returns the names of the option arguments.
 func (a *Args) OptionNames() []string {
 	optNames := make([]string, 0)
@@ -82,15 +236,37 @@ func (a *Args) NonOptionArgs() []string {
 	return a.nonOptsArgs
 }
 
-// addOptionArgs method adds a new option argument to the arguments.
+// OptionPosition method returns the 1-based position of the first argument giving the values of the option
+// with the given name, which is the position of the last flag for an option given as flags.
+func (a *Args) OptionPosition(name string) (int, bool) {
+	position, ok := a.optPositions[name]
+	return position, ok
+}
+
+// addOptionArgs method adds a new option argument to the arguments. The value replaces the value
+// of a flag given before, as the last of them wins.
 func (a *Args) addOptionArgs(name string, value string) {
+	if _, ok := a.flagOpts[name]; ok {
+		a.optArgs[name] = nil
+		delete(a.flagOpts, name)
+	}
+
 	if a.optArgs[name] == nil {
 		a.optArgs[name] = make([]string, 0)
+		a.optPositions[name] = a.position
 	}
 
 	a.optArgs[name] = append(a.optArgs[name], value)
 }
 
+// addFlagOption method adds a boolean option given as a flag such as --name, --no-name or -n. The flag
+// replaces the values of the option given before, as the last of them wins.
+func (a *Args) addFlagOption(name string, value string) {
+	a.optArgs[name] = nil
+	a.addOptionArgs(name, value)
+	a.flagOpts[name] = struct{}{}
+}
+
 // addNonOptionArgs method adds a non-option argument to the arguments.
 func (a *Args) addNonOptionArgs(value string) {
 	a.nonOptsArgs = append(a.nonOptsArgs, value)
@@ -143,6 +319,17 @@ func (s *ArgsPropertySource) Value(key string) (any, bool) {
 	return nil, false
 }
 
+// PropertyOrigin method returns the origin of the option argument with the given name, which is
+// the position of the first argument giving the option.
+func (s *ArgsPropertySource) PropertyOrigin(name string) (config.PropertyOrigin, bool) {
+	position, ok := s.args.OptionPosition(name)
+	if !ok {
+		return config.PropertyOrigin{}, false
+	}
+
+	return config.PropertyOrigin{Resource: fmt.Sprintf("command-line argument #%d", position)}, true
+}
+
 // ValueOrDefault returns the value of the given argument key from the source.
 // If the argument does not exist, it returns the default value.
 func (s *ArgsPropertySource) ValueOrDefault(key string, defaultValue any) any {
@@ -168,3 +355,69 @@ func (s *ArgsPropertySource) OptionValues(name string) []string {
 func (s *ArgsPropertySource) NonOptionArgs() []string {
 	return s.args.NonOptionArgs()
 }
+
+// BindArgs binds the option values of the given arguments to the target struct using the same
+// property tags the config binder understands. Multi-valued options can be bound to slices,
+// and the non-option arguments can be bound using the NonOptionArgs property name.
+func BindArgs(args *Args, target any) error {
+	if args == nil {
+		return errors.New("nil args")
+	}
+
+	propSources := config.NewPropertySources(&argsBindingPropertySource{
+		source: NewArgsPropertySource(args),
+	})
+
+	binder := config.NewDefaultPropertyBinder(propSources)
+	if err := binder.Bind(argsBindingPrefix, target); err != nil {
+		return fmt.Errorf("bind args: %w", err)
+	}
+
+	return nil
+}
+
+// argsBindingPropertySource exposes the arguments under the argsBindingPrefix, as the binder
+// requires the bound properties to share a prefix.
+type argsBindingPropertySource struct {
+	source *ArgsPropertySource
+}
+
+// Name method returns the name of the underlying arguments source.
+func (s *argsBindingPropertySource) Name() string {
+	return s.source.Name()
+}
+
+// Origin method returns the origin of the underlying arguments source.
+func (s *argsBindingPropertySource) Origin() string {
+	return s.source.Origin()
+}
+
+// Value method returns the value of the given prefixed property name.
+func (s *argsBindingPropertySource) Value(key string) (any, bool) {
+	name, ok := strings.CutPrefix(key, argsBindingPrefix+".")
+	if !ok {
+		return nil, false
+	}
+
+	return s.source.Value(name)
+}
+
+// ValueOrDefault method returns the value of the given prefixed property name or the default value.
+func (s *argsBindingPropertySource) ValueOrDefault(key string, defaultValue any) any {
+	val, ok := s.Value(key)
+	if !ok {
+		return defaultValue
+	}
+
+	return val
+}
+
+// PropertyNames method returns the prefixed option names.
+func (s *argsBindingPropertySource) PropertyNames() []string {
+	names := s.source.PropertyNames()
+	for index, name := range names {
+		names[index] = argsBindingPrefix + "." + name
+	}
+
+	return names
+}
@@ -27,25 +27,156 @@ func TestParseArgs(t *testing.T) {
 	testCases := []struct {
 		name              string
 		args              []string
+		valueOptions      []string
 		wantErr           error
 		wantOptionArgs    map[string][]string
 		wantNonOptionArgs []string
 	}{
 		{
-			name:    "wrong arg format",
-			args:    []string{"--argKey3"},
-			wantErr: errors.New("wrong argument format '--argKey3'"),
+			name:    "empty long option name",
+			args:    []string{"--=arg3Val"},
+			wantErr: errors.New("wrong argument format '--=arg3Val': empty option name"),
+		},
+		{
+			name:    "invalid long option name",
+			args:    []string{"---argKey3"},
+			wantErr: errors.New("wrong argument format '---argKey3': invalid option name '-argKey3'"),
+		},
+		{
+			name:    "long option name with whitespace",
+			args:    []string{"--arg Key3=arg3Val"},
+			wantErr: errors.New("wrong argument format '--arg Key3=arg3Val': invalid option name 'arg Key3': contains whitespace"),
+		},
+		{
+			name:    "empty negated option name",
+			args:    []string{"--no-"},
+			wantErr: errors.New("wrong argument format '--no-': empty option name"),
+		},
+		{
+			name:    "empty short option name",
+			args:    []string{"-=arg3Val"},
+			wantErr: errors.New("wrong argument format '-=arg3Val': empty option name"),
+		},
+		{
+			name:    "invalid short option",
+			args:    []string{"-a!"},
+			wantErr: errors.New("wrong argument format '-a!': invalid short option '!'"),
+		},
+		{
+			name: "boolean long options",
+			args: []string{"--verbose", "--no-color", "--debug", "--color"},
+			wantOptionArgs: map[string][]string{
+				"verbose": {"true"},
+				"color":   {"true"},
+				"debug":   {"true"},
+			},
+			wantNonOptionArgs: []string{},
+		},
+		{
+			name: "last flag wins",
+			args: []string{"--color", "--no-color", "-vq", "--no-v", "--cache=yes", "--no-cache", "--debug", "--debug=false", "--debug=true"},
+			wantOptionArgs: map[string][]string{
+				"color": {"false"},
+				"v":     {"false"},
+				"q":     {"true"},
+				"cache": {"false"},
+				"debug": {"false", "true"},
+			},
+			wantNonOptionArgs: []string{},
+		},
+		{
+			name:         "space-separated values",
+			args:         []string{"--port", "8080", "--offset", "-5", "--input", "-", "--name=value", "--no-cache=yes", "file"},
+			valueOptions: []string{"port", "offset", "input"},
+			wantOptionArgs: map[string][]string{
+				"port":     {"8080"},
+				"offset":   {"-5"},
+				"input":    {"-"},
+				"name":     {"value"},
+				"no-cache": {"yes"},
+			},
+			wantNonOptionArgs: []string{"file"},
+		},
+		{
+			name:         "short options",
+			args:         []string{"-abc", "-p", "8080", "-vf=out.txt", "-x", "-1.5", "-y"},
+			valueOptions: []string{"p", "x"},
+			wantOptionArgs: map[string][]string{
+				"a": {"true"},
+				"b": {"true"},
+				"c": {"true"},
+				"p": {"8080"},
+				"v": {"true"},
+				"f": {"out.txt"},
+				"x": {"-1.5"},
+				"y": {"true"},
+			},
+			wantNonOptionArgs: []string{},
+		},
+		{
+			name:         "boolean options followed by a command",
+			args:         []string{"--verbose", "migrate", "-q", "up", "--target", "v2"},
+			valueOptions: []string{"target"},
+			wantOptionArgs: map[string][]string{
+				"verbose": {"true"},
+				"q":       {"true"},
+				"target":  {"v2"},
+			},
+			wantNonOptionArgs: []string{"migrate", "up"},
+		},
+		{
+			name:         "value option without value",
+			args:         []string{"--port", "--verbose"},
+			valueOptions: []string{"port"},
+			wantErr:      errors.New("wrong argument format '--port': option 'port' requires a value"),
+		},
+		{
+			name:         "short value option at the end",
+			args:         []string{"-p"},
+			valueOptions: []string{"p"},
+			wantErr:      errors.New("wrong argument format '-p': option 'p' requires a value"),
+		},
+		{
+			name: "end of options",
+			args: []string{"run", "--verbose", "--", "--not-an-option", "-x"},
+			wantOptionArgs: map[string][]string{
+				"verbose": {"true"},
+			},
+			wantNonOptionArgs: []string{"run", "--not-an-option", "-x"},
+		},
+		{
+			name:              "negative number and dash as non-option args",
+			args:              []string{"-5", "-", "-1.5e3", "-.5", "-2."},
+			wantOptionArgs:    map[string][]string{},
+			wantNonOptionArgs: []string{"-5", "-", "-1.5e3", "-.5", "-2."},
+		},
+		{
+			name:         "special float values as options",
+			args:         []string{"--offset", "-inf", "-nan", "-infinity"},
+			valueOptions: []string{"offset"},
+			wantErr:      errors.New("wrong argument format '--offset': option 'offset' requires a value"),
+		},
+		{
+			name: "special float values as short options",
+			args: []string{"-inf", "-nan"},
+			wantOptionArgs: map[string][]string{
+				"i": {"true"},
+				"n": {"true"},
+				"f": {"true"},
+				"a": {"true"},
+			},
+			wantNonOptionArgs: []string{},
 		},
 		{
 			name: "valid args",
-			args: []string{"argKey1", "-argKey2", "--argKey3=arg3Val", "--argKey3=arg3AnotherVal"},
+			args: []string{"argKey1", "argKey2", "--argKey3=arg3Val", "--argKey3=arg3AnotherVal"},
 			wantOptionArgs: map[string][]string{
 				"argKey3": {
 					"arg3Val",
 					"arg3AnotherVal",
 				},
 			},
-			wantNonOptionArgs: []string{"argKey1", "-argKey2"},
+			wantNonOptionArgs: []string{"argKey1", "argKey2"},
 		},
 	}
 
@@ -54,7 +185,7 @@ func TestParseArgs(t *testing.T) {
 			// given
 
 			// when
-			args, err := ParseArgs(tc.args)
+			args, err := ParseArgs(tc.args, tc.valueOptions...)
 
 			// then
 			if tc.wantErr != nil {
@@ -80,12 +211,12 @@ func TestArgs_OptionNames(t *testing.T) {
 	}{
 		{
 			name:            "option does not exist",
-			args:            []string{"argKey1", "-argKey2", "--argKey3=arg3Val"},
+			args:            []string{"argKey1", "argKey2", "--argKey3=arg3Val"},
 			wantOptionNames: []string{"argKey3"},
 		},
 		{
 			name:            "non-option args",
-			args:            []string{"argKey1", "-argKey2"},
+			args:            []string{"argKey1", "argKey2"},
 			wantOptionNames: []string{},
 		},
 	}
@@ -114,7 +245,7 @@ func TestArgs_ContainsOption(t *testing.T) {
 	}{
 		{
 			name:       "option does not exist",
-			args:       []string{"argKey1", "-argKey2", "--argKey3=arg3Val"},
+			args:       []string{"argKey1", "argKey2", "--argKey3=arg3Val"},
 			optionName: "argKey4",
 			wantResult: false,
 		},
@@ -151,19 +282,19 @@ func TestArgs_OptionValues(t *testing.T) {
 		{
 			name:       "option does not exist",
 			optionName: "argKey4",
-			args:       []string{"argKey1", "-argKey2", "--argKey3=arg3Val"},
+			args:       []string{"argKey1", "argKey2", "--argKey3=arg3Val"},
 			wantValues: nil,
 		},
 		{
 			name:       "option with one value",
 			optionName: "argKey3",
-			args:       []string{"argKey1", "-argKey2", "--argKey3=arg3Val"},
+			args:       []string{"argKey1", "argKey2", "--argKey3=arg3Val"},
 			wantValues: []string{"arg3Val"},
 		},
 		{
 			name:       "option with multiple values",
 			optionName: "argKey3",
-			args:       []string{"argKey1", "-argKey2", "--argKey3=arg3Val", "--argKey3=arg3AnotherVal"},
+			args:       []string{"argKey1", "argKey2", "--argKey3=arg3Val", "--argKey3=arg3AnotherVal"},
 			wantValues: []string{"arg3Val", "arg3AnotherVal"},
 		},
 	}
@@ -185,14 +316,14 @@ func TestArgs_OptionValues(t *testing.T) {
 
 func TestArgs_NonOptionArgs(t *testing.T) {
 	// given
-	args, err := ParseArgs([]string{"argKey1", "-argKey2", "--argKey3=arg3Val", "--argKey3=arg3AnotherVal"})
+	args, err := ParseArgs([]string{"argKey1", "argKey2", "--argKey3=arg3Val", "--argKey3=arg3AnotherVal"})
 	require.NoError(t, err)
 
 	// when
 	values := args.NonOptionArgs()
 
 	// then
-	assert.Equal(t, []string{"argKey1", "-argKey2"}, values)
+	assert.Equal(t, []string{"argKey1", "argKey2"}, values)
 }
 
 func TestNewArgsPropertySource(t *testing.T) {
@@ -260,6 +391,56 @@ func TestArgsPropertySource_Origin(t *testing.T) {
 	assert.Equal(t, "commandLineArgs", origin)
 }
 
+func TestArgsPropertySource_PropertyOrigin(t *testing.T) {
+	testCases := []struct {
+		name       string
+		args       []string
+		propName   string
+		wantExists bool
+		wantOrigin string
+	}{
+		{
+			name:       "option does not exist",
+			args:       []string{"--server.port=8080"},
+			propName:   "server.host",
+			wantExists: false,
+		},
+		{
+			name:       "option",
+			args:       []string{"run", "--server.port", "8080", "--server.host=localhost"},
+			propName:   "server.host",
+			wantExists: true,
+			wantOrigin: "command-line argument #4",
+		},
+		{
+			name:       "repeated option",
+			args:       []string{"--tag=a", "-v", "--tag=b"},
+			propName:   "tag",
+			wantExists: true,
+			wantOrigin: "command-line argument #1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			args, err := ParseArgs(tc.args)
+			require.NoError(t, err)
+
+			argsPropSource := NewArgsPropertySource(args)
+
+			// when
+			origin, exists := argsPropSource.PropertyOrigin(tc.propName)
+
+			// then
+			assert.Equal(t, tc.wantExists, exists)
+			if tc.wantExists {
+				assert.Equal(t, tc.wantOrigin, origin.String())
+			}
+		})
+	}
+}
+
 func TestArgsPropertySource_Value(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -270,16 +451,16 @@ func TestArgsPropertySource_Value(t *testing.T) {
 	}{
 		{
 			name:       "option does not exist",
-			args:       []string{"argKey1", "-argKey2", "--argKey3=arg3Val"},
+			args:       []string{"argKey1", "argKey2", "--argKey3=arg3Val"},
 			propName:   "argKey4",
 			wantExists: false,
 		},
 		{
 			name:       "non-option args",
-			args:       []string{"argKey1", "-argKey2", "--argKey3=arg3Val"},
+			args:       []string{"argKey1", "argKey2", "--argKey3=arg3Val"},
 			propName:   NonOptionArgs,
 			wantExists: true,
-			wantValue:  "argKey1,-argKey2",
+			wantValue:  "argKey1,argKey2",
 		},
 		{
 			name:       "no non-option args",
@@ -324,17 +505,17 @@ func TestArgsPropertySource_ValueOrDefault(t *testing.T) {
 	}{
 		{
 			name:         "option does not exist",
-			args:         []string{"argKey1", "-argKey2", "--argKey3=arg3Val"},
+			args:         []string{"argKey1", "argKey2", "--argKey3=arg3Val"},
 			propName:     "argKey4",
 			defaultValue: "anyDefaultValue",
 			wantValue:    "anyDefaultValue",
 		},
 		{
 			name:         "non-option args",
-			args:         []string{"argKey1", "-argKey2", "--argKey3=arg3Val"},
+			args:         []string{"argKey1", "argKey2", "--argKey3=arg3Val"},
 			propName:     NonOptionArgs,
 			defaultValue: "anyDefaultValue",
-			wantValue:    "argKey1,-argKey2",
+			wantValue:    "argKey1,argKey2",
 		},
 		{
 			name:         "no non-option args",
@@ -377,12 +558,12 @@ func TestArgsPropertySource_PropertyNames(t *testing.T) {
 	}{
 		{
 			name:          "option does not exist",
-			args:          []string{"argKey1", "-argKey2", "--argKey3=arg3Val"},
+			args:          []string{"argKey1", "argKey2", "--argKey3=arg3Val"},
 			wantPropNames: []string{"argKey3"},
 		},
 		{
 			name:          "non-option args",
-			args:          []string{"argKey1", "-argKey2"},
+			args:          []string{"argKey1", "argKey2"},
 			wantPropNames: []string{},
 		},
 	}
@@ -414,19 +595,19 @@ func TestArgsPropertySource_OptionValues(t *testing.T) {
 		{
 			name:       "option does not exist",
 			propName:   "argKey4",
-			args:       []string{"argKey1", "-argKey2", "--argKey3=arg3Val"},
+			args:       []string{"argKey1", "argKey2", "--argKey3=arg3Val"},
 			wantValues: nil,
 		},
 		{
 			name:       "option with one value",
 			propName:   "argKey3",
-			args:       []string{"argKey1", "-argKey2", "--argKey3=arg3Val"},
+			args:       []string{"argKey1", "argKey2", "--argKey3=arg3Val"},
 			wantValues: []string{"arg3Val"},
 		},
 		{
 			name:       "option with multiple values",
 			propName:   "argKey3",
-			args:       []string{"argKey1", "-argKey2", "--argKey3=arg3Val", "--argKey3=arg3AnotherVal"},
+			args:       []string{"argKey1", "argKey2", "--argKey3=arg3Val", "--argKey3=arg3AnotherVal"},
 			wantValues: []string{"arg3Val", "arg3AnotherVal"},
 		},
 	}
@@ -450,7 +631,7 @@ func TestArgsPropertySource_OptionValues(t *testing.T) {
 
 func TestArgsPropertySource_NonOptionArgs(t *testing.T) {
 	// given
-	args, err := ParseArgs([]string{"argKey1", "-argKey2", "--argKey3=arg3Val", "--argKey3=arg3AnotherVal"})
+	args, err := ParseArgs([]string{"argKey1", "argKey2", "--argKey3=arg3Val", "--argKey3=arg3AnotherVal"})
 	require.NoError(t, err)
 
 	argsPropSource := NewArgsPropertySource(args)
@@ -459,5 +640,84 @@ func TestArgsPropertySource_NonOptionArgs(t *testing.T) {
 	values := argsPropSource.NonOptionArgs()
 
 	// then
-	assert.Equal(t, []string{"argKey1", "-argKey2"}, values)
+	assert.Equal(t, []string{"argKey1", "argKey2"}, values)
+}
+
+type anyCommandOptions struct {
+	Port    int      `property:"port"`
+	Verbose bool     `property:"verbose,default=false"`
+	Tags    []string `property:"tag,optional"`
+	Command []string `property:"nonOptionArgs,optional"`
+}
+
+func TestBindArgs(t *testing.T) {
+	testCases := []struct {
+		name        string
+		args        []string
+		nilArgs     bool
+		wantErr     error
+		wantOptions anyCommandOptions
+	}{
+		{
+			name:    "nil args",
+			nilArgs: true,
+			wantErr: errors.New("nil args"),
+		},
+		{
+			name: "missing required option",
+			args: []string{"--verbose=true"},
+			wantErr: errors.New("bind args: bind property \"args\" to runtime.anyCommandOptions: " +
+				"struct field \"Port\": property \"args.port\" to int: required"),
+		},
+		{
+			name: "invalid option value",
+			args: []string{"--port=abc"},
+			wantErr: errors.New("bind args: bind property \"args\" to runtime.anyCommandOptions: " +
+				"struct field \"Port\": property \"args.port\" to int: strconv.ParseInt: parsing \"abc\": invalid syntax"),
+		},
+		{
+			name: "valid args",
+			args: []string{"migrate", "--port=8080", "--tag=a", "--tag=b", "up"},
+			wantOptions: anyCommandOptions{
+				Port:    8080,
+				Verbose: false,
+				Tags:    []string{"a", "b"},
+				Command: []string{"migrate", "up"},
+			},
+		},
+		{
+			name: "negated flag after flag",
+			args: []string{"--port=8080", "--verbose", "--no-verbose"},
+			wantOptions: anyCommandOptions{
+				Port:    8080,
+				Verbose: false,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var args *Args
+			if !tc.nilArgs {
+				var err error
+				args, err = ParseArgs(tc.args)
+				require.NoError(t, err)
+			}
+
+			options := anyCommandOptions{}
+
+			// when
+			err := BindArgs(args, &options)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOptions, options)
+		})
+	}
 }
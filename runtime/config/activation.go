@@ -0,0 +1,350 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// ActivateOnProfileProp is the property key for specifying the profile expressions a config document
+	// is activated on.
+	ActivateOnProfileProp = "procyon.config.activate.on-profile"
+	// ActivateOnPropertyProp is the property key for specifying the property conditions a config document
+	// is activated on.
+	ActivateOnPropertyProp = "procyon.config.activate.on-property"
+)
+
+// Activation describes when a config document is active. A document is active if any of its profile
+// expressions matches the profiles and all of its property conditions match the properties.
+type Activation struct {
+	onProfile  []string
+	profiles   []profileExpression
+	onProperty []propertyCondition
+}
+
+// propertyCondition is a condition on a property. Without a value, the property must exist and must not
+// be false.
+type propertyCondition struct {
+	name     string
+	value    string
+	hasValue bool
+}
+
+// activationOf parses the activation of the given config document. It returns nil if the document is always
+// active.
+func activationOf(propSource PropertySource) (*Activation, error) {
+	onProfile := propertyList(propSource, ActivateOnProfileProp)
+	onProperty := propertyList(propSource, ActivateOnPropertyProp)
+
+	if len(onProfile) == 0 && len(onProperty) == 0 {
+		return nil, nil
+	}
+
+	activation := &Activation{
+		onProfile: onProfile,
+	}
+
+	for _, expr := range onProfile {
+		profiles, err := parseProfileExpression(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s '%s': %w", ActivateOnProfileProp, expr, err)
+		}
+
+		activation.profiles = append(activation.profiles, profiles)
+	}
+
+	for _, condition := range onProperty {
+		name, value, hasValue := strings.Cut(condition, "=")
+		name = strings.TrimSpace(name)
+
+		if name == "" {
+			return nil, fmt.Errorf("invalid %s '%s': empty property name", ActivateOnPropertyProp, condition)
+		}
+
+		activation.onProperty = append(activation.onProperty, propertyCondition{
+			name:     name,
+			value:    strings.TrimSpace(value),
+			hasValue: hasValue,
+		})
+	}
+
+	return activation, nil
+}
+
+// IsActive reports whether a document with the activation is active for the given profiles and properties.
+func (a *Activation) IsActive(profiles []string, resolver PropertyResolver) bool {
+	if len(a.profiles) != 0 {
+		isProfileActive := func(profile string) bool {
+			return slices.Contains(profiles, profile)
+		}
+
+		matched := slices.ContainsFunc(a.profiles, func(expr profileExpression) bool {
+			return expr(isProfileActive)
+		})
+
+		if !matched {
+			return false
+		}
+	}
+
+	for _, condition := range a.onProperty {
+		if !condition.matches(resolver) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsProfileSpecific reports whether the activation depends on the profiles.
+func (a *Activation) IsProfileSpecific() bool {
+	return len(a.profiles) != 0
+}
+
+// String returns the activation in a human-readable form.
+func (a *Activation) String() string {
+	parts := make([]string, 0, 2)
+
+	if len(a.onProfile) != 0 {
+		parts = append(parts, fmt.Sprintf("on-profile %s", strings.Join(a.onProfile, ", ")))
+	}
+
+	if len(a.onProperty) != 0 {
+		conditions := make([]string, 0, len(a.onProperty))
+		for _, condition := range a.onProperty {
+			conditions = append(conditions, condition.String())
+		}
+
+		parts = append(parts, fmt.Sprintf("on-property %s", strings.Join(conditions, ", ")))
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+// matches reports whether the condition matches the properties of the given resolver.
+func (c propertyCondition) matches(resolver PropertyResolver) bool {
+	if resolver == nil {
+		return false
+	}
+
+	value, ok := resolver.Lookup(c.name)
+	if !ok || value == nil {
+		return false
+	}
+
+	actual := strings.TrimSpace(fmt.Sprint(value))
+	if !c.hasValue {
+		return !strings.EqualFold(actual, "false")
+	}
+
+	return actual == c.value
+}
+
+// String returns the condition in its textual form.
+func (c propertyCondition) String() string {
+	if !c.hasValue {
+		return c.name
+	}
+
+	return c.name + "=" + c.value
+}
+
+// propertyList returns the comma-separated values of the given property, which may be a list as well.
+func propertyList(propSource PropertySource, key string) []string {
+	values := make([]string, 0)
+
+	appendValues := func(value any) {
+		if value == nil {
+			return
+		}
+
+		for _, part := range strings.Split(fmt.Sprint(value), ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				values = append(values, part)
+			}
+		}
+	}
+
+	if value, ok := propSource.Value(key); ok {
+		appendValues(value)
+	}
+
+	for index := 0; ; index++ {
+		value, ok := propSource.Value(fmt.Sprintf("%s[%d]", key, index))
+		if !ok {
+			break
+		}
+
+		appendValues(value)
+	}
+
+	return values
+}
+
+// profileExpression reports whether a profile expression matches, using the given function to check
+// whether a profile is active.
+type profileExpression func(isProfileActive func(profile string) bool) bool
+
+// parseProfileExpression parses a profile expression such as "prod & !eu". An expression consists of
+// profile names, the ! (not), & (and) and | (or) operators, and parentheses. The & and | operators cannot
+// be mixed without parentheses.
+func parseProfileExpression(expr string) (profileExpression, error) {
+	parser := &profileExpressionParser{
+		tokens: tokenizeProfileExpression(expr),
+	}
+
+	if len(parser.tokens) == 0 {
+		return nil, errors.New("empty profile expression")
+	}
+
+	result, err := parser.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.position != len(parser.tokens) {
+		return nil, fmt.Errorf("unexpected '%s'", parser.tokens[parser.position])
+	}
+
+	return result, nil
+}
+
+// tokenizeProfileExpression splits the given expression into operators, parentheses and profile names.
+func tokenizeProfileExpression(expr string) []string {
+	tokens := make([]string, 0)
+	start := -1
+
+	for i, c := range expr {
+		switch {
+		case strings.ContainsRune("!&|()", c) || c == ' ' || c == '\t':
+			if start != -1 {
+				tokens = append(tokens, expr[start:i])
+				start = -1
+			}
+
+			if c != ' ' && c != '\t' {
+				tokens = append(tokens, string(c))
+			}
+		case start == -1:
+			start = i
+		}
+	}
+
+	if start != -1 {
+		tokens = append(tokens, expr[start:])
+	}
+
+	return tokens
+}
+
+// profileExpressionParser is a recursive descent parser of profile expressions.
+type profileExpressionParser struct {
+	tokens   []string
+	position int
+}
+
+// parseExpression parses a sequence of terms joined by the same operator.
+func (p *profileExpressionParser) parseExpression() (profileExpression, error) {
+	first, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+
+	terms := []profileExpression{first}
+	operator := ""
+
+	for p.position < len(p.tokens) && (p.tokens[p.position] == "&" || p.tokens[p.position] == "|") {
+		token := p.tokens[p.position]
+		if operator != "" && operator != token {
+			return nil, errors.New("mixed '&' and '|' operators without parentheses")
+		}
+
+		operator = token
+		p.position++
+
+		term, termErr := p.parseTerm()
+		if termErr != nil {
+			return nil, termErr
+		}
+
+		terms = append(terms, term)
+	}
+
+	if operator == "&" {
+		return func(isProfileActive func(profile string) bool) bool {
+			for _, term := range terms {
+				if !term(isProfileActive) {
+					return false
+				}
+			}
+
+			return true
+		}, nil
+	}
+
+	return func(isProfileActive func(profile string) bool) bool {
+		for _, term := range terms {
+			if term(isProfileActive) {
+				return true
+			}
+		}
+
+		return false
+	}, nil
+}
+
+// parseTerm parses a negated term, a parenthesized expression or a profile name.
+func (p *profileExpressionParser) parseTerm() (profileExpression, error) {
+	if p.position == len(p.tokens) {
+		return nil, errors.New("unexpected end of expression")
+	}
+
+	token := p.tokens[p.position]
+	p.position++
+
+	switch token {
+	case "!":
+		term, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+
+		return func(isProfileActive func(profile string) bool) bool {
+			return !term(isProfileActive)
+		}, nil
+	case "(":
+		expr, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.position == len(p.tokens) || p.tokens[p.position] != ")" {
+			return nil, errors.New("missing ')'")
+		}
+
+		p.position++
+		return expr, nil
+	case "&", "|", ")":
+		return nil, fmt.Errorf("unexpected '%s'", token)
+	default:
+		return func(isProfileActive func(profile string) bool) bool {
+			return isProfileActive(token)
+		}, nil
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProfileExpression(t *testing.T) {
+	testCases := []struct {
+		name      string
+		expr      string
+		profiles  []string
+		wantMatch bool
+		wantErr   error
+	}{
+		{name: "single profile", expr: "prod", profiles: []string{"prod"}, wantMatch: true},
+		{name: "single profile not active", expr: "prod", profiles: []string{"dev"}, wantMatch: false},
+		{name: "not", expr: "!eu", profiles: []string{"prod"}, wantMatch: true},
+		{name: "and", expr: "prod & !eu", profiles: []string{"prod", "us"}, wantMatch: true},
+		{name: "and not matching", expr: "prod & !eu", profiles: []string{"prod", "eu"}, wantMatch: false},
+		{name: "or", expr: "dev | test", profiles: []string{"test"}, wantMatch: true},
+		{name: "or not matching", expr: "dev|test", profiles: []string{"prod"}, wantMatch: false},
+		{name: "parentheses", expr: "(dev | test) & !(eu & us)", profiles: []string{"dev", "eu"}, wantMatch: true},
+		{name: "double negation", expr: "!!prod", profiles: []string{"prod"}, wantMatch: true},
+		{name: "empty", expr: " ", wantErr: errors.New("empty profile expression")},
+		{name: "mixed operators", expr: "a & b | c", wantErr: errors.New("mixed '&' and '|' operators without parentheses")},
+		{name: "missing closing parenthesis", expr: "(a | b", wantErr: errors.New("missing ')'")},
+		{name: "unexpected closing parenthesis", expr: "a)", wantErr: errors.New("unexpected ')'")},
+		{name: "dangling operator", expr: "a &", wantErr: errors.New("unexpected end of expression")},
+		{name: "leading operator", expr: "| a", wantErr: errors.New("unexpected '|'")},
+		{name: "missing operator", expr: "a b", wantErr: errors.New("unexpected 'b'")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			expr, err := parseProfileExpression(tc.expr)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantMatch, expr(func(profile string) bool {
+				for _, active := range tc.profiles {
+					if active == profile {
+						return true
+					}
+				}
+
+				return false
+			}))
+		})
+	}
+}
+
+func TestActivation(t *testing.T) {
+	resolver := NewDefaultPropertyResolver(NewPropertySources(NewMapPropertySource("anySource", map[string]any{
+		"feature.enabled":  true,
+		"feature.disabled": "false",
+		"region":           "eu",
+	})))
+
+	testCases := []struct {
+		name           string
+		values         map[string]any
+		profiles       []string
+		wantActivation bool
+		wantActive     bool
+		wantString     string
+		wantErr        error
+	}{
+		{
+			name:   "no activation",
+			values: map[string]any{"app.name": "demo"},
+		},
+		{
+			name:           "on profile",
+			values:         map[string]any{"procyon.config.activate.on-profile": "prod & !eu"},
+			profiles:       []string{"prod"},
+			wantActivation: true,
+			wantActive:     true,
+			wantString:     "on-profile prod & !eu",
+		},
+		{
+			name:           "on profile list",
+			values:         map[string]any{"procyon.config.activate.on-profile": []any{"dev", "test"}},
+			profiles:       []string{"test"},
+			wantActivation: true,
+			wantActive:     true,
+			wantString:     "on-profile dev, test",
+		},
+		{
+			name:           "on profile comma-separated",
+			values:         map[string]any{"procyon.config.activate.on-profile": "dev, test"},
+			profiles:       []string{"prod"},
+			wantActivation: true,
+			wantActive:     false,
+			wantString:     "on-profile dev, test",
+		},
+		{
+			name:           "on property",
+			values:         map[string]any{"procyon.config.activate.on-property": "feature.enabled, region=eu"},
+			wantActivation: true,
+			wantActive:     true,
+			wantString:     "on-property feature.enabled, region=eu",
+		},
+		{
+			name:           "on property false",
+			values:         map[string]any{"procyon.config.activate.on-property": "feature.disabled"},
+			wantActivation: true,
+			wantActive:     false,
+			wantString:     "on-property feature.disabled",
+		},
+		{
+			name:           "on property with different value",
+			values:         map[string]any{"procyon.config.activate.on-property": "region=us"},
+			wantActivation: true,
+			wantActive:     false,
+			wantString:     "on-property region=us",
+		},
+		{
+			name:           "on property missing",
+			values:         map[string]any{"procyon.config.activate.on-property": "feature.missing"},
+			wantActivation: true,
+			wantActive:     false,
+			wantString:     "on-property feature.missing",
+		},
+		{
+			name: "on profile and on property",
+			values: map[string]any{
+				"procyon.config.activate.on-profile":  "prod",
+				"procyon.config.activate.on-property": "region=eu",
+			},
+			profiles:       []string{"prod"},
+			wantActivation: true,
+			wantActive:     true,
+			wantString:     "on-profile prod; on-property region=eu",
+		},
+		{
+			name:    "invalid profile expression",
+			values:  map[string]any{"procyon.config.activate.on-profile": "prod &"},
+			wantErr: errors.New("invalid procyon.config.activate.on-profile 'prod &': unexpected end of expression"),
+		},
+		{
+			name:    "invalid property condition",
+			values:  map[string]any{"procyon.config.activate.on-property": "=eu"},
+			wantErr: errors.New("invalid procyon.config.activate.on-property '=eu': empty property name"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			propSource := NewMapPropertySource("anyDocument", tc.values)
+
+			// when
+			activation, err := activationOf(propSource)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			if !tc.wantActivation {
+				assert.Nil(t, activation)
+				return
+			}
+
+			require.NotNil(t, activation)
+			assert.Equal(t, tc.wantActive, activation.IsActive(tc.profiles, resolver))
+			assert.Equal(t, tc.wantString, activation.String())
+		})
+	}
+}
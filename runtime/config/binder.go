@@ -35,10 +35,12 @@ type PropertyBinder interface {
 type DefaultPropertyBinder struct {
 	propSources  *PropertySources
 	propResolver PropertyResolver
+	converters   *Converters
 }
 
 // NewDefaultPropertyBinder function creates a new DefaultPropertyBinder with the provided property sources.
-func NewDefaultPropertyBinder(propSources *PropertySources) *DefaultPropertyBinder {
+// The given converters take precedence over the built-in converters.
+func NewDefaultPropertyBinder(propSources *PropertySources, converters ...Converter) *DefaultPropertyBinder {
 	if propSources == nil {
 		panic("nil property sources")
 	}
@@ -46,6 +48,7 @@ func NewDefaultPropertyBinder(propSources *PropertySources) *DefaultPropertyBind
 	return &DefaultPropertyBinder{
 		propSources:  propSources,
 		propResolver: NewDefaultPropertyResolver(propSources),
+		converters:   NewConverters(converters...),
 	}
 }
 
@@ -65,38 +68,67 @@ func (b *DefaultPropertyBinder) Bind(name string, target any) error {
 		return fmt.Errorf("bind %q: non-nil pointer required", name)
 	}
 
-	err := b.bindValue(name, targetVal.Elem())
-	if err != nil {
+	if err := b.bind(name, targetVal.Elem()); err != nil {
+		return err
+	}
+
+	if err := b.validate(name, targetVal.Elem()); err != nil {
 		return fmt.Errorf("bind property %q to %s: %w", name, targetVal.Elem().Type(), err)
 	}
 
 	return nil
 }
 
-// findProperty method searches for the property with the given name in the property sources.
-func (b *DefaultPropertyBinder) findProperty(name string) (any, bool) {
-	for _, source := range b.propSources.Slice() {
-		if value, ok := source.Value(name); ok {
-			return value, true
+// bind method binds the properties with the given name to the target value without validating it.
+func (b *DefaultPropertyBinder) bind(name string, targetVal reflect.Value) error {
+	err := b.bindValue(name, targetVal, ConvertOptions{})
+	if err != nil {
+		return fmt.Errorf("bind property %q%s to %s: %w", name, b.originSuffix(name), targetVal.Type(), err)
+	}
+
+	return nil
+}
+
+// originSuffix method returns the origin of the given property in the form of " (from origin)",
+// or an empty string if the source of the property does not know its origin.
+func (b *DefaultPropertyBinder) originSuffix(name string) string {
+	propSource, propName, ok := findProperty(b.propSources, name)
+	if !ok {
+		return ""
+	}
+
+	if lookup, isLookup := propSource.(OriginLookup); isLookup {
+		if origin, exists := lookup.PropertyOrigin(propName); exists {
+			return fmt.Sprintf(" (from %s)", origin)
 		}
 	}
 
-	return nil, false
+	return ""
+}
+
+// findProperty method searches for the property with the given name in the property sources.
+// String values are returned with their placeholders expanded.
+func (b *DefaultPropertyBinder) findProperty(name string) (any, bool) {
+	return b.propResolver.Lookup(name)
 }
 
 // bindValue method binds the value to the target based on its kind.
-func (b *DefaultPropertyBinder) bindValue(name string, targetVal reflect.Value) error {
+func (b *DefaultPropertyBinder) bindValue(name string, targetVal reflect.Value, opts ConvertOptions) error {
+	if b.converters.CanConvert(targetVal.Type()) {
+		return b.bindScalar(name, targetVal, opts)
+	}
+
 	switch targetVal.Kind() {
 	case reflect.Map:
 		return b.bindMap(name, targetVal)
 	case reflect.Slice:
-		return b.bindSlice(name, targetVal)
+		return b.bindSlice(name, targetVal, opts)
 	case reflect.Struct:
 		return b.bindStruct(name, targetVal)
 	case reflect.Interface:
 		return b.bindAny(name, targetVal)
 	default:
-		return b.bindScalar(name, targetVal)
+		return b.bindScalar(name, targetVal, opts)
 	}
 }
 
@@ -112,19 +144,23 @@ func (b *DefaultPropertyBinder) bindAny(name string, targetVal reflect.Value) er
 }
 
 // bindScalar method binds a scalar property to the target.
-func (b *DefaultPropertyBinder) bindScalar(name string, target reflect.Value) error {
+func (b *DefaultPropertyBinder) bindScalar(name string, target reflect.Value, opts ConvertOptions) error {
 	val, ok := b.findProperty(name)
 	if !ok {
 		return ErrPropertyNotFound
 	}
 
+	if b.converters.CanConvert(target.Type()) {
+		return b.setValue(target, val, opts)
+	}
+
 	return b.setScalar(target, val)
 }
 
 // bindSlice method binds a slice property to the target.
-func (b *DefaultPropertyBinder) bindSlice(name string, targetVal reflect.Value) error {
+func (b *DefaultPropertyBinder) bindSlice(name string, targetVal reflect.Value, opts ConvertOptions) error {
 	if v, ok := b.findProperty(name); ok {
-		err := b.setValue(targetVal, v)
+		err := b.setValue(targetVal, v, opts)
 		if err != nil {
 			return err
 		}
@@ -136,9 +172,13 @@ func (b *DefaultPropertyBinder) bindSlice(name string, targetVal reflect.Value)
 
 	for i := 0; i < math.MaxInt64; i++ {
 		indexedName := fmt.Sprintf("%s[%d]", name, i)
+		if _, _, ok := findDescendant(b.propSources, indexedName); !ok {
+			break
+		}
+
 		elemVal := reflect.New(elemType).Elem()
 
-		err := b.bindValue(indexedName, elemVal)
+		err := b.bindValue(indexedName, elemVal, opts)
 		if err != nil {
 			if errors.Is(err, ErrPropertyNotFound) {
 				break
@@ -163,28 +203,15 @@ func (b *DefaultPropertyBinder) bindMap(name string, target reflect.Value) error
 		target.Set(reflect.MakeMap(target.Type()))
 	}
 
-	rootKey := fmt.Sprintf("%s.", name)
-	subKeys := make(map[string]struct{})
-	for _, propSrc := range b.propSources.Slice() {
-		for _, propName := range propSrc.PropertyNames() {
-			if strings.HasPrefix(propName, rootKey) {
-				fieldKey := strings.TrimPrefix(propName, rootKey)
-				fieldKey = strings.TrimSpace(fieldKey)
-				parts := strings.SplitN(fieldKey, ".", 2)
-				subKeys[parts[0]] = struct{}{}
-			}
-		}
-	}
-
-	for subKey := range subKeys {
+	for _, child := range childProperties(b.propSources, name) {
 		valType := target.Type().Elem()
 		val := reflect.New(valType)
-		err := b.Bind(name+"."+subKey, val.Interface())
+		err := b.bind(child.name, val.Elem())
 		if err != nil {
 			return err
 		}
 
-		key := reflect.ValueOf(subKey)
+		key := reflect.ValueOf(child.key)
 		target.SetMapIndex(key, val.Elem())
 	}
 
@@ -224,25 +251,26 @@ func (b *DefaultPropertyBinder) bindStruct(name string, targetVal reflect.Value)
 
 		propName := fmt.Sprintf("%s.%s", name, propTag.Name)
 
-		err = b.bindValue(propName, fieldVal)
+		opts := ConvertOptions{Layout: propTag.Layout}
+		err = b.bindValue(propName, fieldVal, opts)
 
 		if errors.Is(err, ErrPropertyNotFound) {
 			if propTag.Default != nil {
-				err = b.setValue(fieldVal, propTag.Default)
+				err = b.setValue(fieldVal, propTag.Default, opts)
 				if err != nil {
 					return fmt.Errorf("struct field %q: property %q to %s: default value %q: %w", field.Name, propName, field.Type, propTag.Default, err)
 				}
 			} else if !propTag.Optional {
-				return fmt.Errorf("struct field %q: property %q to %s: required", field.Name, propName, field.Type)
+				return fmt.Errorf("struct field %q: property %q to %s: %w", field.Name, propName, field.Type, &MissingPropertyError{Name: propName})
 			}
 		} else if err != nil {
-			return fmt.Errorf("struct field %q: property %q to %s: %w", field.Name, propName, field.Type, err)
+			return fmt.Errorf("struct field %q: property %q%s to %s: %w", field.Name, propName, b.originSuffix(propName), field.Type, err)
 		}
 
 		switch field.Type.Kind() {
 		case reflect.Slice, reflect.Map:
 			if fieldVal.Len() == 0 && propTag.Default != nil {
-				err = b.setValue(fieldVal, propTag.Default)
+				err = b.setValue(fieldVal, propTag.Default, opts)
 				if err != nil {
 					return fmt.Errorf("struct field %q: property %q to %s: default value %q: %w", field.Name, propName, field.Type, propTag.Default, err)
 				}
@@ -255,7 +283,8 @@ func (b *DefaultPropertyBinder) bindStruct(name string, targetVal reflect.Value)
 	return nil
 }
 
-func (b *DefaultPropertyBinder) setValue(target reflect.Value, val any) error {
+// setValue method sets the value to the target, converting it with the converters if needed.
+func (b *DefaultPropertyBinder) setValue(target reflect.Value, val any, opts ConvertOptions) error {
 	source := reflect.ValueOf(val)
 
 	if source.Type().AssignableTo(target.Type()) {
@@ -263,6 +292,16 @@ func (b *DefaultPropertyBinder) setValue(target reflect.Value, val any) error {
 		return nil
 	}
 
+	if b.converters.CanConvert(target.Type()) {
+		converted, err := b.converters.Convert(val, target.Type(), opts)
+		if err != nil {
+			return err
+		}
+
+		target.Set(reflect.ValueOf(converted))
+		return nil
+	}
+
 	if source.Type().ConvertibleTo(target.Type()) {
 		target.Set(source.Convert(target.Type()))
 		return nil
@@ -270,9 +309,9 @@ func (b *DefaultPropertyBinder) setValue(target reflect.Value, val any) error {
 
 	switch target.Kind() {
 	case reflect.Slice:
-		return b.copySlice(target, source)
+		return b.copySlice(target, source, opts)
 	case reflect.Map:
-		return b.copyMap(target, source)
+		return b.copyMap(target, source, opts)
 	default:
 		return b.setScalar(target, val)
 	}
@@ -314,7 +353,7 @@ func (b *DefaultPropertyBinder) setScalar(target reflect.Value, val any) error {
 	return nil
 }
 
-func (b *DefaultPropertyBinder) copySlice(target reflect.Value, source reflect.Value) error {
+func (b *DefaultPropertyBinder) copySlice(target reflect.Value, source reflect.Value, opts ConvertOptions) error {
 	if source.Kind() == reflect.String {
 		parts := strings.Split(source.String(), ",")
 		out := reflect.MakeSlice(target.Type(), 0, len(parts))
@@ -324,7 +363,7 @@ func (b *DefaultPropertyBinder) copySlice(target reflect.Value, source reflect.V
 			p = strings.TrimSpace(p)
 			elem := reflect.New(elemType).Elem()
 
-			if err := b.setValue(elem, p); err != nil {
+			if err := b.setValue(elem, p, opts); err != nil {
 				return fmt.Errorf("string value %q: %w", source.String(), err)
 			}
 
@@ -346,7 +385,7 @@ func (b *DefaultPropertyBinder) copySlice(target reflect.Value, source reflect.V
 
 		elem := reflect.New(elemType).Elem()
 
-		if err := b.setValue(elem, sv.Interface()); err != nil {
+		if err := b.setValue(elem, sv.Interface(), opts); err != nil {
 			return err
 		}
 
@@ -357,7 +396,7 @@ func (b *DefaultPropertyBinder) copySlice(target reflect.Value, source reflect.V
 	return nil
 }
 
-func (b *DefaultPropertyBinder) copyMap(target reflect.Value, source reflect.Value) error {
+func (b *DefaultPropertyBinder) copyMap(target reflect.Value, source reflect.Value, opts ConvertOptions) error {
 	keyType := target.Type().Key()
 	valType := target.Type().Elem()
 
@@ -375,12 +414,12 @@ func (b *DefaultPropertyBinder) copyMap(target reflect.Value, source reflect.Val
 		}
 
 		k := reflect.New(keyType).Elem()
-		if err := b.setValue(k, key.Interface()); err != nil {
+		if err := b.setValue(k, key.Interface(), opts); err != nil {
 			return err
 		}
 
 		v := reflect.New(valType).Elem()
-		if err := b.setValue(v, val.Interface()); err != nil {
+		if err := b.setValue(v, val.Interface(), opts); err != nil {
 			return err
 		}
 
@@ -3,8 +3,14 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
 	"reflect"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -69,6 +75,41 @@ type ConfigWithInvalidDefaultValue struct {
 	Timeout int `property:"timeout,default='thirty'"`
 }
 
+type ConfigWithConvertedTypes struct {
+	Timeout   time.Duration       `property:"timeout"`
+	Retry     time.Duration       `property:"retry,default=5s"`
+	MaxSize   DataSize            `property:"maxSize"`
+	Started   time.Time           `property:"started"`
+	Released  time.Time           `property:"released,layout=2006-01-02"`
+	Pattern   *regexp.Regexp      `property:"pattern"`
+	Endpoint  *url.URL            `property:"endpoint"`
+	Addr      net.IP              `property:"addr"`
+	Network   *net.IPNet          `property:"network"`
+	Prefix    netip.Prefix        `property:"prefix"`
+	Intervals []time.Duration     `property:"intervals"`
+	Limits    map[string]DataSize `property:"limits"`
+}
+
+type ConfigWithDataSize struct {
+	MaxSize DataSize `property:"maxSize"`
+}
+
+type ConfigWithInvalidDuration struct {
+	Timeout time.Duration `property:"timeout,default=thirty"`
+}
+
+type upperString string
+
+type upperStringConverter struct{}
+
+func (upperStringConverter) CanConvert(targetType reflect.Type) bool {
+	return targetType == reflect.TypeFor[upperString]()
+}
+
+func (upperStringConverter) Convert(value any, _ reflect.Type, _ ConvertOptions) (any, error) {
+	return upperString(strings.ToUpper(fmt.Sprint(value))), nil
+}
+
 func TestNewDefaultBinder(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -832,6 +873,59 @@ func TestDefaultBinder_Bind(t *testing.T) {
 			targetType: reflect.TypeFor[ConfigWithInvalidDefaultValue](),
 			wantErr:    errors.New("bind property \"config\" to config.ConfigWithInvalidDefaultValue: struct field \"Timeout\": property \"config.timeout\" to int: default value \"thirty\": strconv.ParseInt: parsing \"thirty\": invalid syntax"),
 		},
+		{
+			name: "struct property with converted types",
+			propSource: NewMapPropertySource("anyMapSource", map[string]any{
+				"config.timeout":   "30s",
+				"config.maxSize":   "10MB",
+				"config.started":   "2026-01-02T15:04:05Z",
+				"config.released":  "2026-03-04",
+				"config.pattern":   "^v[0-9]+$",
+				"config.endpoint":  "https://example.com/api",
+				"config.addr":      "10.0.0.1",
+				"config.network":   "10.0.0.0/8",
+				"config.prefix":    "192.168.0.0/16",
+				"config.intervals": "1s, 5m",
+				"config.limits": map[string]any{
+					"upload": "2GB",
+				},
+			}),
+			propName:   "config",
+			targetType: reflect.TypeFor[ConfigWithConvertedTypes](),
+			wantResult: ConfigWithConvertedTypes{
+				Timeout:  30 * time.Second,
+				Retry:    5 * time.Second,
+				MaxSize:  10 * Megabyte,
+				Started:  time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC),
+				Released: time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC),
+				Pattern:  regexp.MustCompile("^v[0-9]+$"),
+				Endpoint: &url.URL{Scheme: "https", Host: "example.com", Path: "/api"},
+				Addr:     net.ParseIP("10.0.0.1"),
+				Network: &net.IPNet{
+					IP:   net.IP{10, 0, 0, 0},
+					Mask: net.CIDRMask(8, 32),
+				},
+				Prefix:    netip.MustParsePrefix("192.168.0.0/16"),
+				Intervals: []time.Duration{time.Second, 5 * time.Minute},
+				Limits:    map[string]DataSize{"upload": 2 * Gigabyte},
+			},
+		},
+		{
+			name: "struct property with invalid converted value",
+			propSource: NewMapPropertySource("anyMapSource", map[string]any{
+				"config.maxSize": "tenMB",
+			}),
+			propName:   "config",
+			targetType: reflect.TypeFor[ConfigWithDataSize](),
+			wantErr:    errors.New("bind property \"config\" to config.ConfigWithDataSize: struct field \"MaxSize\": property \"config.maxSize\" to config.DataSize: invalid data size \"tenMB\""),
+		},
+		{
+			name:       "struct property with invalid default duration",
+			propSource: NewMapPropertySource("anyMapSource", map[string]any{}),
+			propName:   "config",
+			targetType: reflect.TypeFor[ConfigWithInvalidDuration](),
+			wantErr:    errors.New("bind property \"config\" to config.ConfigWithInvalidDuration: struct field \"Timeout\": property \"config.timeout\" to time.Duration: default value \"thirty\": time: invalid duration \"thirty\""),
+		},
 		{
 			name: "struct property with invalid default slice value",
 			propSource: NewMapPropertySource("anyMapSource", map[string]any{
@@ -871,3 +965,172 @@ func TestDefaultBinder_Bind(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultBinder_BindWithConverter(t *testing.T) {
+	// given
+	propSources := NewPropertySources(NewMapPropertySource("anyMapSource", map[string]any{
+		"config.name": "anyName",
+	}))
+	binder := NewDefaultPropertyBinder(propSources, upperStringConverter{})
+
+	target := &struct {
+		Name upperString `property:"name"`
+	}{}
+
+	// when
+	err := binder.Bind("config", target)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, upperString("ANYNAME"), target.Name)
+}
+
+func TestDefaultBinder_BindErrorWithOrigin(t *testing.T) {
+	// given
+	propSources := NewPropertySources(NewOriginTrackedMapPropertySource("anySourceName", map[string]any{
+		"server.port": "eighty",
+	}, map[string]PropertyOrigin{
+		"server.port": {Resource: "resources/procyon.yaml", Line: 2, Column: 3},
+	}))
+	binder := NewDefaultPropertyBinder(propSources)
+
+	target := &struct {
+		Port int `property:"port"`
+	}{}
+
+	// when
+	err := binder.Bind("server", target)
+
+	// then
+	require.EqualError(t, err, "bind property \"server\" to struct { Port int \"property:\\\"port\\\"\" }: "+
+		"struct field \"Port\": property \"server.port\" (from resources/procyon.yaml:2:3) to int: "+
+		"strconv.ParseInt: parsing \"eighty\": invalid syntax")
+}
+
+func TestDefaultBinder_BindRelaxedNames(t *testing.T) {
+	testCases := []struct {
+		name   string
+		values map[string]any
+	}{
+		{
+			name: "kebab case",
+			values: map[string]any{
+				"server.max-connections": 10,
+				"server.hosts[0].name":   "anyHost",
+				"server.hosts[0].port":   8080,
+				"server.hosts[1].name":   "anotherHost",
+				"server.labels.team":     "anyTeam",
+			},
+		},
+		{
+			name: "snake case",
+			values: map[string]any{
+				"server.max_connections": 10,
+				"server.hosts[0].name":   "anyHost",
+				"server.hosts[0].port":   8080,
+				"server.hosts[1].name":   "anotherHost",
+				"server.labels.team":     "anyTeam",
+			},
+		},
+		{
+			name: "camel case",
+			values: map[string]any{
+				"server.maxConnections": 10,
+				"server.hosts[0].name":  "anyHost",
+				"server.hosts[0].port":  8080,
+				"server.hosts[1].name":  "anotherHost",
+				"server.labels.team":    "anyTeam",
+			},
+		},
+		{
+			name: "env form",
+			values: map[string]any{
+				"SERVER_MAXCONNECTIONS": "10",
+				"SERVER_HOSTS_0_NAME":   "anyHost",
+				"SERVER_HOSTS_0_PORT":   "8080",
+				"SERVER_HOSTS_1_NAME":   "anotherHost",
+				"SERVER_LABELS_TEAM":    "anyTeam",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			propSources := NewPropertySources(NewMapPropertySource("anyMapSource", tc.values))
+			binder := NewDefaultPropertyBinder(propSources)
+
+			type host struct {
+				Name string `property:"name"`
+				Port int    `property:"port,default=80"`
+			}
+
+			target := &struct {
+				MaxConnections int               `property:"max-connections"`
+				Hosts          []host            `property:"hosts"`
+				Labels         map[string]string `property:"labels"`
+			}{}
+
+			// when
+			err := binder.Bind("server", target)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, 10, target.MaxConnections)
+			assert.Equal(t, []host{{Name: "anyHost", Port: 8080}, {Name: "anotherHost", Port: 80}}, target.Hosts)
+			assert.Equal(t, map[string]string{"team": "anyTeam"}, target.Labels)
+		})
+	}
+}
+
+func TestDefaultBinder_BindMapKeys(t *testing.T) {
+	testCases := []struct {
+		name       string
+		values     map[string]any
+		wantLabels map[string]string
+	}{
+		{
+			name: "keys differing in case",
+			values: map[string]any{
+				"server.labels.Foo": "anyValue",
+				"server.labels.foo": "anotherValue",
+			},
+			wantLabels: map[string]string{"Foo": "anyValue", "foo": "anotherValue"},
+		},
+		{
+			name: "keys differing in separators",
+			values: map[string]any{
+				"server.labels.x-id": "anyValue",
+				"server.labels.xid":  "anotherValue",
+			},
+			wantLabels: map[string]string{"x-id": "anyValue", "xid": "anotherValue"},
+		},
+		{
+			name: "keys under relaxed paths",
+			values: map[string]any{
+				"SERVER.LABELS.Foo": "anyValue",
+				"server.Labels.foo": "anotherValue",
+			},
+			wantLabels: map[string]string{"Foo": "anyValue", "foo": "anotherValue"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			propSources := NewPropertySources(NewMapPropertySource("anyMapSource", tc.values))
+			binder := NewDefaultPropertyBinder(propSources)
+
+			target := &struct {
+				Labels map[string]string `property:"labels"`
+			}{}
+
+			// when
+			err := binder.Bind("server", target)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantLabels, target.Labels)
+		})
+	}
+}
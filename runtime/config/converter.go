@@ -0,0 +1,291 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding"
+	"fmt"
+	"net"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ConvertOptions holds the options given by the property tag that affect a conversion.
+type ConvertOptions struct {
+	// Layout is the layout used to parse times. If it is empty, time.RFC3339 is used.
+	Layout string
+}
+
+// Converter is an interface for converting property values to a target type.
+// Converters registered as components are used by the property binder in addition to the built-in ones.
+type Converter interface {
+	// CanConvert reports whether the converter converts values to the given target type.
+	CanConvert(targetType reflect.Type) bool
+	// Convert converts the given value to the target type.
+	Convert(value any, targetType reflect.Type, opts ConvertOptions) (any, error)
+}
+
+// Converters is a registry of converters. The converters added to the registry take precedence
+// over the built-in converters for durations, times, regular expressions, URLs, IPs, CIDRs and
+// the types implementing encoding.TextUnmarshaler.
+type Converters struct {
+	items    []Converter
+	builtins []Converter
+	mu       sync.RWMutex
+}
+
+// NewConverters creates a new Converters with the given converters and the built-in converters.
+func NewConverters(converters ...Converter) *Converters {
+	result := &Converters{
+		items: make([]Converter, 0, len(converters)),
+		builtins: []Converter{
+			durationConverter{},
+			timeConverter{},
+			regexpConverter{},
+			urlConverter{},
+			ipConverter{},
+			cidrConverter{},
+			textUnmarshalerConverter{},
+		},
+	}
+
+	for _, converter := range converters {
+		result.Add(converter)
+	}
+
+	return result
+}
+
+// Add adds the converter to the registry.
+func (c *Converters) Add(converter Converter) {
+	if converter == nil {
+		panic("nil converter")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items = append(c.items, converter)
+}
+
+// Find returns the first converter that converts values to the given target type.
+func (c *Converters) Find(targetType reflect.Type) (Converter, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, converter := range c.items {
+		if converter.CanConvert(targetType) {
+			return converter, true
+		}
+	}
+
+	for _, converter := range c.builtins {
+		if converter.CanConvert(targetType) {
+			return converter, true
+		}
+	}
+
+	return nil, false
+}
+
+// CanConvert reports whether any converter converts values to the given target type.
+func (c *Converters) CanConvert(targetType reflect.Type) bool {
+	_, ok := c.Find(targetType)
+	return ok
+}
+
+// Convert converts the given value to the target type using the first converter supporting it.
+func (c *Converters) Convert(value any, targetType reflect.Type, opts ConvertOptions) (any, error) {
+	converter, ok := c.Find(targetType)
+	if !ok {
+		return nil, fmt.Errorf("no converter for %s", targetType)
+	}
+
+	result, err := converter.Convert(value, targetType, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil || !reflect.TypeOf(result).AssignableTo(targetType) {
+		return nil, fmt.Errorf("converter %T returned %T instead of %s", converter, result, targetType)
+	}
+
+	return result, nil
+}
+
+var (
+	durationType        = reflect.TypeFor[time.Duration]()
+	timeType            = reflect.TypeFor[time.Time]()
+	regexpPtrType       = reflect.TypeFor[*regexp.Regexp]()
+	urlType             = reflect.TypeFor[url.URL]()
+	urlPtrType          = reflect.TypeFor[*url.URL]()
+	ipType              = reflect.TypeFor[net.IP]()
+	ipNetType           = reflect.TypeFor[net.IPNet]()
+	ipNetPtrType        = reflect.TypeFor[*net.IPNet]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// durationConverter converts values like 30s or 5m to time.Duration. The values without a unit, given
+// as strings or integers, are rejected as their unit would be ambiguous, except 0.
+type durationConverter struct{}
+
+func (durationConverter) CanConvert(targetType reflect.Type) bool {
+	return targetType == durationType
+}
+
+func (durationConverter) Convert(value any, _ reflect.Type, _ ConvertOptions) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return time.ParseDuration(strings.TrimSpace(v))
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		i, err := toInt64(v, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		// an integer is parsed like a string, so that it is rejected unless it is 0
+		return time.ParseDuration(strconv.FormatInt(i, 10))
+	default:
+		return nil, unsupportedValueError(value, durationType)
+	}
+}
+
+// timeConverter converts values to time.Time using the layout option or time.RFC3339.
+type timeConverter struct{}
+
+func (timeConverter) CanConvert(targetType reflect.Type) bool {
+	return targetType == timeType
+}
+
+func (timeConverter) Convert(value any, _ reflect.Type, opts ConvertOptions) (any, error) {
+	str, ok := value.(string)
+	if !ok {
+		return nil, unsupportedValueError(value, timeType)
+	}
+
+	layout := opts.Layout
+	if layout == "" {
+		layout = time.RFC3339
+	}
+
+	return time.Parse(layout, strings.TrimSpace(str))
+}
+
+// regexpConverter compiles values to *regexp.Regexp.
+type regexpConverter struct{}
+
+func (regexpConverter) CanConvert(targetType reflect.Type) bool {
+	return targetType == regexpPtrType
+}
+
+func (regexpConverter) Convert(value any, _ reflect.Type, _ ConvertOptions) (any, error) {
+	return regexp.Compile(fmt.Sprint(value))
+}
+
+// urlConverter parses values to url.URL or *url.URL.
+type urlConverter struct{}
+
+func (urlConverter) CanConvert(targetType reflect.Type) bool {
+	return targetType == urlType || targetType == urlPtrType
+}
+
+func (urlConverter) Convert(value any, targetType reflect.Type, _ ConvertOptions) (any, error) {
+	u, err := url.Parse(strings.TrimSpace(fmt.Sprint(value)))
+	if err != nil {
+		return nil, err
+	}
+
+	if targetType == urlType {
+		return *u, nil
+	}
+
+	return u, nil
+}
+
+// ipConverter parses values to net.IP.
+type ipConverter struct{}
+
+func (ipConverter) CanConvert(targetType reflect.Type) bool {
+	return targetType == ipType
+}
+
+func (ipConverter) Convert(value any, _ reflect.Type, _ ConvertOptions) (any, error) {
+	str := strings.TrimSpace(fmt.Sprint(value))
+
+	ip := net.ParseIP(str)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address %q", str)
+	}
+
+	return ip, nil
+}
+
+// cidrConverter parses values in CIDR notation to net.IPNet or *net.IPNet.
+type cidrConverter struct{}
+
+func (cidrConverter) CanConvert(targetType reflect.Type) bool {
+	return targetType == ipNetType || targetType == ipNetPtrType
+}
+
+func (cidrConverter) Convert(value any, targetType reflect.Type, _ ConvertOptions) (any, error) {
+	_, ipNet, err := net.ParseCIDR(strings.TrimSpace(fmt.Sprint(value)))
+	if err != nil {
+		return nil, err
+	}
+
+	if targetType == ipNetType {
+		return *ipNet, nil
+	}
+
+	return ipNet, nil
+}
+
+// textUnmarshalerConverter converts values to the types implementing encoding.TextUnmarshaler.
+type textUnmarshalerConverter struct{}
+
+func (textUnmarshalerConverter) CanConvert(targetType reflect.Type) bool {
+	if targetType.Kind() == reflect.Pointer {
+		return targetType.Implements(textUnmarshalerType)
+	}
+
+	return reflect.PointerTo(targetType).Implements(textUnmarshalerType)
+}
+
+func (textUnmarshalerConverter) Convert(value any, targetType reflect.Type, _ ConvertOptions) (any, error) {
+	elemType := targetType
+	if targetType.Kind() == reflect.Pointer {
+		elemType = targetType.Elem()
+	}
+
+	result := reflect.New(elemType)
+	if err := result.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(fmt.Sprint(value))); err != nil {
+		return nil, err
+	}
+
+	if targetType.Kind() == reflect.Pointer {
+		return result.Interface(), nil
+	}
+
+	return result.Elem().Interface(), nil
+}
+
+// unsupportedValueError returns an error for a value that cannot be converted to the target type.
+func unsupportedValueError(value any, targetType reflect.Type) error {
+	return fmt.Errorf("value \"%v\": cannot convert %T to %s", value, value, targetType)
+}
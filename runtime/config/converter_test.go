@@ -0,0 +1,166 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type anyWrongConverter struct{}
+
+func (anyWrongConverter) CanConvert(targetType reflect.Type) bool {
+	return targetType == reflect.TypeFor[upperString]()
+}
+
+func (anyWrongConverter) Convert(value any, _ reflect.Type, _ ConvertOptions) (any, error) {
+	return 1, nil
+}
+
+func TestConverters_Add(t *testing.T) {
+	// given
+	converters := NewConverters()
+
+	// when
+	// then
+	assert.PanicsWithValue(t, "nil converter", func() {
+		converters.Add(nil)
+	})
+}
+
+func TestConverters_Convert(t *testing.T) {
+	testCases := []struct {
+		name       string
+		converters []Converter
+		value      any
+		targetType reflect.Type
+		opts       ConvertOptions
+		wantResult any
+		wantErr    error
+	}{
+		{
+			name:       "duration",
+			value:      "1m30s",
+			targetType: reflect.TypeFor[time.Duration](),
+			wantResult: 90 * time.Second,
+		},
+		{
+			name:       "duration from zero integer",
+			value:      0,
+			targetType: reflect.TypeFor[time.Duration](),
+			wantResult: time.Duration(0),
+		},
+		{
+			name:       "duration from integer without unit",
+			value:      1000,
+			targetType: reflect.TypeFor[time.Duration](),
+			wantErr:    errors.New("time: missing unit in duration \"1000\""),
+		},
+		{
+			name:       "duration from string without unit",
+			value:      "30",
+			targetType: reflect.TypeFor[time.Duration](),
+			wantErr:    errors.New("time: missing unit in duration \"30\""),
+		},
+		{
+			name:       "invalid duration",
+			value:      true,
+			targetType: reflect.TypeFor[time.Duration](),
+			wantErr:    errors.New("value \"true\": cannot convert bool to time.Duration"),
+		},
+		{
+			name:       "time with default layout",
+			value:      "2026-01-02T15:04:05+03:00",
+			targetType: reflect.TypeFor[time.Time](),
+			wantResult: time.Date(2026, 1, 2, 15, 4, 5, 0, time.FixedZone("", 3*60*60)),
+		},
+		{
+			name:       "time with layout",
+			value:      "02/01/2026",
+			targetType: reflect.TypeFor[time.Time](),
+			opts:       ConvertOptions{Layout: "02/01/2006"},
+			wantResult: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "invalid ip",
+			value:      "10.0.0",
+			targetType: reflect.TypeFor[net.IP](),
+			wantErr:    errors.New("invalid IP address \"10.0.0\""),
+		},
+		{
+			name:       "cidr",
+			value:      "10.1.0.0/16",
+			targetType: reflect.TypeFor[net.IPNet](),
+			wantResult: net.IPNet{IP: net.IP{10, 1, 0, 0}, Mask: net.CIDRMask(16, 32)},
+		},
+		{
+			name:       "text unmarshaler",
+			value:      512,
+			targetType: reflect.TypeFor[DataSize](),
+			wantResult: 512 * Byte,
+		},
+		{
+			name:       "text unmarshaler pointer",
+			value:      "1KB",
+			targetType: reflect.TypeFor[*DataSize](),
+			wantResult: func() *DataSize { size := Kilobyte; return &size }(),
+		},
+		{
+			name:       "custom converter",
+			converters: []Converter{upperStringConverter{}},
+			value:      "anyValue",
+			targetType: reflect.TypeFor[upperString](),
+			wantResult: upperString("ANYVALUE"),
+		},
+		{
+			name:       "custom converter returning wrong type",
+			converters: []Converter{anyWrongConverter{}},
+			value:      "anyValue",
+			targetType: reflect.TypeFor[upperString](),
+			wantErr:    errors.New("converter config.anyWrongConverter returned int instead of config.upperString"),
+		},
+		{
+			name:       "no converter",
+			value:      "anyValue",
+			targetType: reflect.TypeFor[upperString](),
+			wantErr:    errors.New("no converter for config.upperString"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			converters := NewConverters(tc.converters...)
+
+			// when
+			result, err := converters.Convert(tc.value, tc.targetType, tc.opts)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResult, result)
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"slices"
 	"strings"
@@ -31,12 +32,19 @@ const (
 	DefaultFileName = "procyon"
 	// DefaultProfile is the default profile name.
 	DefaultProfile = "default"
+	// ImportProp is the property key for importing further configuration files or directories
+	// from a configuration file.
+	ImportProp = "procyon.config.import"
+
+	// optionalPrefix is the prefix of the locations and imports that are skipped if they do not exist.
+	optionalPrefix = "optional:"
 )
 
 // Data is a struct that represents a configuration data.
 type Data struct {
 	propertySource PropertySource
 	profile        string
+	activation     *Activation
 }
 
 // DataLoader is an interface that represents a data loader.
@@ -71,10 +79,37 @@ func (d Data) Profile() string {
 	return d.profile
 }
 
+// Activation method returns the activation of the data, given by the procyon.config.activate properties
+// of its document. It returns nil if the data is always active.
+func (d Data) Activation() *Activation {
+	return d.activation
+}
+
 // StandardDataLoader is a struct that represents a default location resolver.
+//
+// The locations, either files or directories, must exist unless they are prefixed with optional:.
+// The config files missing from an existing directory are skipped.
+//
+// The configuration files can import further files or directories with the procyon.config.import
+// property, either as a comma-separated string or as a list. The imports are resolved relative to the
+// importing file, and the ones prefixed with optional: are skipped if they do not exist. The imported
+// configuration takes precedence over the importing file, and the profile-specific variants of the
+// imports, such as db-dev.yaml for db.yaml, are loaded for the profiles as well. A load of the profiles
+// without the default profile loads the variants of the imports found by the last load of the same location.
 type StandardDataLoader struct {
 	resourceResolver io.ResourceResolver
 	loaders          []PropertySourceLoader
+	skipped          []string
+	// the imports of the profile-independent config, whose profile-specific variants are loaded with the profiles
+	imports []dataImport
+	// the imports collected by the last load of each location
+	locationImports map[string][]dataImport
+}
+
+// dataImport is a location imported by a configuration document, along with the activation of the document.
+type dataImport struct {
+	location   string
+	activation *Activation
 }
 
 // NewStandardDataLoader function creates a new StandardDataLoader with the provided property source loaders.
@@ -86,6 +121,7 @@ func NewStandardDataLoader(resourceResolver io.ResourceResolver, loaders ...Prop
 	return &StandardDataLoader{
 		resourceResolver: resourceResolver,
 		loaders:          loaders,
+		locationImports:  make(map[string][]dataImport),
 	}
 }
 
@@ -107,6 +143,14 @@ func (r *StandardDataLoader) Load(ctx context.Context, location string, profiles
 		profiles = append(profiles, DefaultProfile)
 	}
 
+	// the imports are collected again whenever the profile-independent config is loaded, otherwise the ones
+	// collected by the last load of the same location are used
+	r.skipped = make([]string, 0)
+	r.imports = make([]dataImport, 0)
+	if !slices.Contains(profiles, DefaultProfile) {
+		r.imports = append(r.imports, r.locationImports[location]...)
+	}
+
 	for _, profile := range profiles {
 		if profile == DefaultProfile {
 			profile = ""
@@ -123,30 +167,66 @@ func (r *StandardDataLoader) Load(ctx context.Context, location string, profiles
 		data = append(data, loadedData...)
 	}
 
+	r.locationImports[location] = slices.Clone(r.imports)
 	return data, nil
 }
 
+// SkippedLocations returns the config file locations that have been skipped by the last load, as they
+// do not exist or no loader supports their extension.
+func (r *StandardDataLoader) SkippedLocations() []string {
+	return slices.Clone(r.skipped)
+}
+
 // loadData method retrieves configuration data from the given locations for a specific profile.
 func (r *StandardDataLoader) loadData(ctx context.Context, profile string, locations []string) ([]Data, error) {
 	resources := make([]Data, 0)
 
 	for _, location := range locations {
-		isDirectory := strings.HasSuffix(location, "/") || strings.HasSuffix(location, string(os.PathSeparator))
-
-		if isDirectory {
-			dirResources, err := r.loadFromDir(ctx, profile, location)
-			if err != nil {
-				return nil, err
+		var (
+			locResources []Data
+			err          error
+		)
+
+		location = strings.TrimSpace(location)
+		optional := strings.HasPrefix(location, optionalPrefix)
+		location = strings.TrimSpace(strings.TrimPrefix(location, optionalPrefix))
+
+		if !optional {
+			if err = r.checkExists(ctx, location); err != nil {
+				return nil, fmt.Errorf("location %s: %w", location, err)
 			}
+		}
 
-			resources = append(resources, dirResources...)
+		if isDirectory(location) {
+			locResources, err = r.loadFromDir(ctx, profile, location, nil)
 		} else {
-			fileResources, err := r.loadFromFile(ctx, profile, location)
-			if err != nil {
-				return nil, err
+			locResources, err = r.loadFromFile(ctx, profile, location, nil)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, locResources...)
+	}
+
+	if profile == "" {
+		return resources, nil
+	}
+
+	// the profile-specific variants of the imports made by the profile-independent config
+	for _, imported := range slices.Clone(r.imports) {
+		variantResources, err := r.loadImportVariant(ctx, profile, imported.location, nil)
+		if err != nil {
+			return nil, fmt.Errorf("import %s: %w", imported.location, err)
+		}
+
+		for _, variant := range variantResources {
+			if variant.activation == nil {
+				variant.activation = imported.activation
 			}
 
-			resources = append(resources, fileResources...)
+			resources = append(resources, variant)
 		}
 	}
 
@@ -154,8 +234,7 @@ func (r *StandardDataLoader) loadData(ctx context.Context, profile string, locat
 }
 
 // loadFromDir method loads configuration data from a directory for a specific profile.
-func (r *StandardDataLoader) loadFromDir(ctx context.Context, profile string, location string) ([]Data, error) {
-
+func (r *StandardDataLoader) loadFromDir(ctx context.Context, profile string, location string, chain []string) ([]Data, error) {
 	data := make([]Data, 0)
 
 	for _, loader := range r.loaders {
@@ -176,15 +255,16 @@ func (r *StandardDataLoader) loadFromDir(ctx context.Context, profile string, lo
 			}
 
 			if !resource.Exists() {
+				r.skipped = append(r.skipped, filePath)
 				continue
 			}
 
-			propSource, loadErr := loader.Load(ctx, filePath, resource)
+			docData, loadErr := r.loadDocuments(ctx, loader, filePath, resource, profile, chain)
 			if loadErr != nil {
 				return nil, loadErr
 			}
 
-			data = append(data, NewData(propSource, profile))
+			data = append(data, docData...)
 		}
 	}
 
@@ -192,35 +272,244 @@ func (r *StandardDataLoader) loadFromDir(ctx context.Context, profile string, lo
 }
 
 // loadFromFile method loads configuration data from a file for a specific profile.
-func (r *StandardDataLoader) loadFromFile(ctx context.Context, profile string, file string) ([]Data, error) {
-
+func (r *StandardDataLoader) loadFromFile(ctx context.Context, profile string, file string, chain []string) ([]Data, error) {
 	extension := filepath.Ext(file)
 	if extension != "" {
 		extension = extension[1:]
 	}
 
 	data := make([]Data, 0)
+	supported := false
 
 	for _, loader := range r.loaders {
 		if slices.Contains(loader.Extensions(), extension) {
+			supported = true
+
 			resource, err := r.resourceResolver.Resolve(ctx, file)
 			if err != nil {
 				return nil, err
 			}
 
 			if !resource.Exists() {
+				r.skipped = append(r.skipped, file)
 				continue
 			}
 
-			propSource, loadErr := loader.Load(ctx, file, resource)
+			docData, loadErr := r.loadDocuments(ctx, loader, file, resource, profile, chain)
 			if loadErr != nil {
 				return nil, loadErr
 			}
 
-			data = append(data, NewData(propSource, profile))
+			data = append(data, docData...)
+		}
+	}
+
+	if !supported {
+		r.skipped = append(r.skipped, file)
+	}
+
+	return data, nil
+}
+
+// loadDocuments method loads the configuration data of each document of a resource, together with their
+// activations and imports. The loaders not implementing MultiDocumentPropertySourceLoader load a single
+// document. The chain holds the files importing the resource, and is used to detect the import cycles.
+func (r *StandardDataLoader) loadDocuments(ctx context.Context, loader PropertySourceLoader, file string, resource io.Resource,
+	profile string, chain []string) ([]Data, error) {
+	if slices.Contains(chain, file) {
+		return nil, &CircularImportError{Chain: append(slices.Clone(chain), file)}
+	}
+
+	var (
+		propSources []PropertySource
+		err         error
+	)
+
+	if multiDocLoader, ok := loader.(MultiDocumentPropertySourceLoader); ok {
+		propSources, err = multiDocLoader.LoadDocuments(ctx, file, resource)
+	} else {
+		var propSource PropertySource
+		propSource, err = loader.Load(ctx, file, resource)
+		propSources = []PropertySource{propSource}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	chain = append(slices.Clone(chain), file)
+	data := make([]Data, 0, len(propSources))
+	for _, propSource := range propSources {
+		activation, activationErr := activationOf(propSource)
+		if activationErr != nil {
+			return nil, fmt.Errorf("config file %s: %w", propSource.Name(), activationErr)
+		}
+
+		docData := NewData(propSource, profile)
+		docData.activation = activation
+		data = append(data, docData)
+
+		importedData, importErr := r.loadImports(ctx, profile, file, docData, chain)
+		if importErr != nil {
+			return nil, fmt.Errorf("config file %s: %w", propSource.Name(), importErr)
+		}
+
+		// the imported documents are active only if the importing document is
+		for _, imported := range importedData {
+			if imported.activation == nil {
+				imported.activation = activation
+			}
+
+			data = append(data, imported)
+		}
+	}
+
+	return data, nil
+}
+
+// loadImports method loads the configuration data imported by a document with the procyon.config.import property.
+func (r *StandardDataLoader) loadImports(ctx context.Context, profile string, file string, docData Data,
+	chain []string) ([]Data, error) {
+	data := make([]Data, 0)
+
+	for _, location := range importLocations(docData.PropertySource()) {
+		optional := strings.HasPrefix(location, optionalPrefix)
+		location = resolveImport(file, strings.TrimSpace(strings.TrimPrefix(location, optionalPrefix)))
+
+		importedData, err := r.loadImport(ctx, profile, location, optional, docData.Activation(), chain)
+		if err != nil {
+			return nil, fmt.Errorf("import %s: %w", location, err)
 		}
+
+		data = append(data, importedData...)
 	}
 
 	return data, nil
+}
+
+// loadImport method loads the configuration data from an imported file or directory, followed by its
+// profile-specific variant. The imports of the profile-independent config are recorded so that their variants
+// are loaded for the profiles later on.
+func (r *StandardDataLoader) loadImport(ctx context.Context, profile string, location string, optional bool,
+	activation *Activation, chain []string) ([]Data, error) {
+	var (
+		data []Data
+		err  error
+	)
+
+	if !optional {
+		if err = r.checkExists(ctx, location); err != nil {
+			return nil, err
+		}
+	}
+
+	if isDirectory(location) {
+		data, err = r.loadFromDir(ctx, "", location, chain)
+	} else {
+		data, err = r.loadFromFile(ctx, "", location, chain)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if profile == "" {
+		imported := dataImport{location: location, activation: activation}
+		if !slices.Contains(r.imports, imported) {
+			r.imports = append(r.imports, imported)
+		}
+
+		return data, nil
+	}
+
+	for index := range data {
+		data[index].profile = profile
+	}
+
+	variantData, err := r.loadImportVariant(ctx, profile, location, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, variantData...), nil
+}
+
+// checkExists method returns an error if the given config file or directory does not exist. A directory is
+// resolved through its "." entry, as its location ends with a path separator.
+func (r *StandardDataLoader) checkExists(ctx context.Context, location string) error {
+	kind := "file"
+	if isDirectory(location) {
+		kind = "directory"
+		location += "."
+	}
+
+	resource, err := r.resourceResolver.Resolve(ctx, location)
+	if err != nil {
+		return err
+	}
+
+	if !resource.Exists() {
+		return fmt.Errorf("config %s not found", kind)
+	}
+
+	return nil
+}
+
+// loadImportVariant method loads the profile-specific variant of an imported file or directory if it exists.
+func (r *StandardDataLoader) loadImportVariant(ctx context.Context, profile string, location string,
+	chain []string) ([]Data, error) {
+	if isDirectory(location) {
+		return r.loadFromDir(ctx, profile, location, chain)
+	}
+
+	extension := filepath.Ext(location)
+	variant := fmt.Sprintf("%s-%s%s", strings.TrimSuffix(location, extension), profile, extension)
+	return r.loadFromFile(ctx, profile, variant, chain)
+}
+
+// importLocations returns the locations imported by the property source, given by the procyon.config.import
+// property either as a comma-separated string or as a list.
+func importLocations(propSource PropertySource) []string {
+	locations := make([]string, 0)
+
+	if value, ok := propSource.Value(ImportProp); ok {
+		for _, location := range strings.Split(fmt.Sprint(value), ",") {
+			if location = strings.TrimSpace(location); location != "" {
+				locations = append(locations, location)
+			}
+		}
+
+		return locations
+	}
+
+	for index := 0; ; index++ {
+		value, ok := propSource.Value(fmt.Sprintf("%s[%d]", ImportProp, index))
+		if !ok {
+			return locations
+		}
+
+		if location := strings.TrimSpace(fmt.Sprint(value)); location != "" {
+			locations = append(locations, location)
+		}
+	}
+}
+
+// resolveImport resolves an imported location relative to the directory of the importing file, unless it is
+// an absolute path or has a scheme.
+func resolveImport(file string, location string) string {
+	if filepath.IsAbs(location) || strings.Contains(location, ":") {
+		return location
+	}
+
+	resolved := path.Join(path.Dir(filepath.ToSlash(file)), filepath.ToSlash(location))
+	if isDirectory(location) {
+		resolved += "/"
+	}
+
+	return resolved
+}
 
+// isDirectory reports whether the location is a directory, which ends with a path separator.
+func isDirectory(location string) bool {
+	return strings.HasSuffix(location, "/") || strings.HasSuffix(location, string(os.PathSeparator))
 }
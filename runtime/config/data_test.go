@@ -17,6 +17,7 @@ package config
 import (
 	"context"
 	"errors"
+	"path"
 	"testing"
 
 	"codnect.io/procyon/io"
@@ -180,6 +181,7 @@ func TestStandardDataLoader_Load(t *testing.T) {
 
 		wantErr        error
 		wantProperties map[string]any
+		wantSkipped    []string
 	}{
 		{
 			name:    "nil context",
@@ -201,6 +203,9 @@ func TestStandardDataLoader_Load(t *testing.T) {
 		{
 			name: "directory: resolve error without profile",
 			preCondition: func(resourceResolver *AnyResourceResolver) {
+				resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"),
+					"resources/.").
+					Return(&AnyResource{exists: true}, nil)
 				resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"),
 					"resources/procyon.yaml").
 					Return(nil, errors.New("resolve error"))
@@ -215,6 +220,9 @@ func TestStandardDataLoader_Load(t *testing.T) {
 		{
 			name: "directory: resolve error with profile",
 			preCondition: func(resourceResolver *AnyResourceResolver) {
+				resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"),
+					"resources/.").
+					Return(&AnyResource{exists: true}, nil)
 				resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"),
 					"resources/procyon-dev.yaml").
 					Return(nil, errors.New("resolve error"))
@@ -230,6 +238,9 @@ func TestStandardDataLoader_Load(t *testing.T) {
 		{
 			name: "directory: resource does not exist",
 			preCondition: func(resourceResolver *AnyResourceResolver) {
+				resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"),
+					"resources/.").
+					Return(&AnyResource{exists: true}, nil)
 				anyResource := &AnyResource{}
 				resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"),
 					"resources/procyon.yaml").
@@ -242,11 +253,27 @@ func TestStandardDataLoader_Load(t *testing.T) {
 			loaders: []PropertySourceLoader{
 				NewYamlPropertySourceLoader(),
 			},
-			location: "resources/",
+			location:    "resources/",
+			wantSkipped: []string{"resources/procyon.yaml", "resources/procyon.yml"},
+		},
+		{
+			name: "file: unsupported extension",
+			preCondition: func(resourceResolver *AnyResourceResolver) {
+				resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"),
+					"resources/procyon.txt").
+					Return(&AnyResource{exists: true}, nil)
+			},
+			ctx:         context.Background(),
+			loaders:     []PropertySourceLoader{NewYamlPropertySourceLoader()},
+			location:    "resources/procyon.txt",
+			wantSkipped: []string{"resources/procyon.txt"},
 		},
 		{
 			name: "directory: resource does not exist with profile",
 			preCondition: func(resourceResolver *AnyResourceResolver) {
+				resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"),
+					"resources/.").
+					Return(&AnyResource{exists: true}, nil)
 				anyResource := &AnyResource{}
 				resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"),
 					"resources/procyon-dev.yaml").
@@ -259,12 +286,16 @@ func TestStandardDataLoader_Load(t *testing.T) {
 			loaders: []PropertySourceLoader{
 				NewYamlPropertySourceLoader(),
 			},
-			location: "resources/",
-			profiles: []string{"dev"},
+			location:    "resources/",
+			profiles:    []string{"dev"},
+			wantSkipped: []string{"resources/procyon-dev.yaml", "resources/procyon-dev.yml"},
 		},
 		{
 			name: "directory: load error without profile",
 			preCondition: func(resourceResolver *AnyResourceResolver) {
+				resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"),
+					"resources/.").
+					Return(&AnyResource{exists: true}, nil)
 				anyResource := &AnyResource{
 					exists: true,
 					err:    errors.New("load error"),
@@ -286,6 +317,9 @@ func TestStandardDataLoader_Load(t *testing.T) {
 		{
 			name: "directory: load error with profile",
 			preCondition: func(resourceResolver *AnyResourceResolver) {
+				resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"),
+					"resources/.").
+					Return(&AnyResource{exists: true}, nil)
 				anyResource := &AnyResource{
 					exists: true,
 					err:    errors.New("load error"),
@@ -311,6 +345,9 @@ func TestStandardDataLoader_Load(t *testing.T) {
 		{
 			name: "directory: load resource",
 			preCondition: func(resourceResolver *AnyResourceResolver) {
+				resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"),
+					"resources/.").
+					Return(&AnyResource{exists: true}, nil)
 				anyResource := &AnyResource{
 					exists: true,
 					reader: &FakeFile{
@@ -349,7 +386,7 @@ func TestStandardDataLoader_Load(t *testing.T) {
 				NewYamlPropertySourceLoader(),
 			},
 			location: "resources/procyon.yaml",
-			wantErr:  errors.New("load config for default profile: resolve error"),
+			wantErr:  errors.New("load config for default profile: location resources/procyon.yaml: resolve error"),
 		},
 		{
 			name: "file: resolve error with default profile",
@@ -363,7 +400,7 @@ func TestStandardDataLoader_Load(t *testing.T) {
 				NewYamlPropertySourceLoader(),
 			},
 			location: "resources/procyon.yaml",
-			wantErr:  errors.New("load config for profile \"dev\": resolve error"),
+			wantErr:  errors.New("load config for profile \"dev\": location resources/procyon.yaml: resolve error"),
 			profiles: []string{"dev"},
 		},
 		{
@@ -378,7 +415,7 @@ func TestStandardDataLoader_Load(t *testing.T) {
 			loaders: []PropertySourceLoader{
 				NewYamlPropertySourceLoader(),
 			},
-			location: "resources/procyon.yaml",
+			location: "optional:resources/procyon.yaml",
 		},
 		{
 			name: "file: resource does not exist with profile",
@@ -392,9 +429,55 @@ func TestStandardDataLoader_Load(t *testing.T) {
 			loaders: []PropertySourceLoader{
 				NewYamlPropertySourceLoader(),
 			},
-			location: "resources/procyon.yaml",
+			location: "optional:resources/procyon.yaml",
 			profiles: []string{"dev"},
 		},
+		{
+			name: "file: required resource does not exist",
+			preCondition: func(resourceResolver *AnyResourceResolver) {
+				resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"),
+					"resources/procyon.yaml").
+					Return(&AnyResource{}, nil)
+			},
+			ctx: context.Background(),
+			loaders: []PropertySourceLoader{
+				NewYamlPropertySourceLoader(),
+			},
+			location: "resources/procyon.yaml",
+			wantErr:  errors.New("load config for default profile: location resources/procyon.yaml: config file not found"),
+		},
+		{
+			name: "directory: required directory does not exist",
+			preCondition: func(resourceResolver *AnyResourceResolver) {
+				resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"),
+					"resources/.").
+					Return(&AnyResource{}, nil)
+			},
+			ctx: context.Background(),
+			loaders: []PropertySourceLoader{
+				NewYamlPropertySourceLoader(),
+			},
+			location: "resources/",
+			wantErr:  errors.New("load config for default profile: location resources/: config directory not found"),
+		},
+		{
+			name: "directory: optional directory does not exist",
+			preCondition: func(resourceResolver *AnyResourceResolver) {
+				anyResource := &AnyResource{}
+				resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"),
+					"resources/procyon.yaml").
+					Return(anyResource, nil)
+				resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"),
+					"resources/procyon.yml").
+					Return(anyResource, nil)
+			},
+			ctx: context.Background(),
+			loaders: []PropertySourceLoader{
+				NewYamlPropertySourceLoader(),
+			},
+			location:    "optional:resources/",
+			wantSkipped: []string{"resources/procyon.yaml", "resources/procyon.yml"},
+		},
 		{
 			name: "file: load error for without profile",
 			preCondition: func(resourceResolver *AnyResourceResolver) {
@@ -480,6 +563,10 @@ func TestStandardDataLoader_Load(t *testing.T) {
 				return
 			}
 
+			if tc.wantSkipped != nil {
+				assert.Equal(t, tc.wantSkipped, dataLoader.SkippedLocations())
+			}
+
 			if len(tc.wantProperties) == 0 {
 				return
 			}
@@ -497,3 +584,284 @@ func TestStandardDataLoader_Load(t *testing.T) {
 		})
 	}
 }
+
+func TestStandardDataLoader_LoadMultiDocument(t *testing.T) {
+	testCases := []struct {
+		name            string
+		contents        string
+		wantActivations []string
+		wantErr         error
+	}{
+		{
+			name:            "documents with activations",
+			contents:        "app.name: demo\n---\nprocyon.config.activate.on-profile: prod & !eu\napp.name: demo-prod\n",
+			wantActivations: []string{"", "on-profile prod & !eu"},
+		},
+		{
+			name:     "invalid activation",
+			contents: "app.name: demo\n---\nprocyon.config.activate.on-profile: prod & | eu\n",
+			wantErr:  errors.New("load config for default profile: config file resources/procyon.yaml (document #1): invalid procyon.config.activate.on-profile 'prod & | eu': unexpected '|'"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			resourceResolver := &AnyResourceResolver{}
+			resourceResolver.On("Resolve", mock.AnythingOfType("context.backgroundCtx"), "resources/procyon.yaml").
+				Return(&AnyResource{exists: true, reader: &FakeFile{contents: tc.contents}}, nil)
+
+			dataLoader := NewStandardDataLoader(resourceResolver, NewYamlPropertySourceLoader())
+
+			// when
+			data, err := dataLoader.Load(context.Background(), "resources/procyon.yaml")
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, data, len(tc.wantActivations))
+
+			for index, wantActivation := range tc.wantActivations {
+				if wantActivation == "" {
+					assert.Nil(t, data[index].Activation())
+					continue
+				}
+
+				require.NotNil(t, data[index].Activation())
+				assert.Equal(t, wantActivation, data[index].Activation().String())
+			}
+		})
+	}
+}
+
+func TestStandardDataLoader_LoadImports(t *testing.T) {
+	testCases := []struct {
+		name      string
+		files     map[string]string
+		location  string
+		profiles  []string
+		wantNames []string
+		wantErr   error
+	}{
+		{
+			name: "import file",
+			files: map[string]string{
+				"resources/procyon.yaml": "procyon.config.import: db.yaml\napp.name: demo\n",
+				"resources/db.yaml":      "db.url: localhost\n",
+			},
+			location:  "resources/procyon.yaml",
+			wantNames: []string{"resources/procyon.yaml", "resources/db.yaml"},
+		},
+		{
+			name: "import list of files and directories",
+			files: map[string]string{
+				"resources/procyon.yaml":      "procyon.config.import:\n  - db.yaml\n  - conf/\n",
+				"resources/db.yaml":           "db.url: localhost\n",
+				"resources/conf/procyon.yaml": "app.name: demo\n",
+			},
+			location:  "resources/",
+			wantNames: []string{"resources/procyon.yaml", "resources/db.yaml", "resources/conf/procyon.yaml"},
+		},
+		{
+			name: "comma-separated imports",
+			files: map[string]string{
+				"resources/procyon.yaml": "procyon.config.import: db.yaml, cache.yaml\n",
+				"resources/db.yaml":      "db.url: localhost\n",
+				"resources/cache.yaml":   "cache.size: 10\n",
+			},
+			location:  "resources/procyon.yaml",
+			wantNames: []string{"resources/procyon.yaml", "resources/db.yaml", "resources/cache.yaml"},
+		},
+		{
+			name: "nested import",
+			files: map[string]string{
+				"resources/procyon.yaml":   "procyon.config.import: conf/db.yaml\n",
+				"resources/conf/db.yaml":   "procyon.config.import: pool.yaml\n",
+				"resources/conf/pool.yaml": "db.pool.size: 10\n",
+			},
+			location:  "resources/procyon.yaml",
+			wantNames: []string{"resources/procyon.yaml", "resources/conf/db.yaml", "resources/conf/pool.yaml"},
+		},
+		{
+			name: "optional import does not exist",
+			files: map[string]string{
+				"resources/procyon.yaml": "procyon.config.import: optional:db.yaml\n",
+			},
+			location:  "resources/procyon.yaml",
+			wantNames: []string{"resources/procyon.yaml"},
+		},
+		{
+			name: "import does not exist",
+			files: map[string]string{
+				"resources/procyon.yaml": "procyon.config.import: db.yaml\n",
+			},
+			location: "resources/procyon.yaml",
+			wantErr: errors.New("load config for default profile: config file resources/procyon.yaml: " +
+				"import resources/db.yaml: config file not found"),
+		},
+		{
+			name: "directory import does not exist",
+			files: map[string]string{
+				"resources/procyon.yaml": "procyon.config.import: conf/\n",
+			},
+			location: "resources/procyon.yaml",
+			wantErr: errors.New("load config for default profile: config file resources/procyon.yaml: " +
+				"import resources/conf/: config directory not found"),
+		},
+		{
+			name: "optional directory import does not exist",
+			files: map[string]string{
+				"resources/procyon.yaml": "procyon.config.import: optional:conf/\n",
+			},
+			location:  "resources/procyon.yaml",
+			wantNames: []string{"resources/procyon.yaml"},
+		},
+		{
+			name: "import cycle",
+			files: map[string]string{
+				"resources/procyon.yaml": "procyon.config.import: db.yaml\n",
+				"resources/db.yaml":      "procyon.config.import: procyon.yaml\n",
+			},
+			location: "resources/procyon.yaml",
+			wantErr: errors.New("load config for default profile: config file resources/procyon.yaml: " +
+				"import resources/db.yaml: config file resources/db.yaml: import resources/procyon.yaml: " +
+				"circular config import resources/procyon.yaml -> resources/db.yaml -> resources/procyon.yaml"),
+		},
+		{
+			name: "profile-specific variants of imports",
+			files: map[string]string{
+				"resources/procyon.yaml":          "procyon.config.import: db.yaml, conf/\n",
+				"resources/db.yaml":               "db.url: localhost\n",
+				"resources/db-dev.yaml":           "db.url: dev-host\n",
+				"resources/conf/procyon.yaml":     "app.name: demo\n",
+				"resources/conf/procyon-dev.yaml": "app.name: demo-dev\n",
+			},
+			location: "resources/",
+			profiles: []string{DefaultProfile, "dev"},
+			wantNames: []string{
+				"resources/procyon.yaml", "resources/db.yaml", "resources/conf/procyon.yaml",
+				"resources/db-dev.yaml", "resources/conf/procyon-dev.yaml",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			resourceResolver := &AnyResourceResolver{}
+			for location, contents := range tc.files {
+				file := &FakeFile{contents: contents}
+				resourceResolver.On("Resolve", mock.Anything, location).
+					Run(func(mock.Arguments) { file.Reset() }).
+					Return(&AnyResource{location: location, exists: true, reader: file}, nil)
+				resourceResolver.On("Resolve", mock.Anything, path.Dir(location)+"/.").
+					Return(&AnyResource{exists: true}, nil)
+			}
+
+			resourceResolver.On("Resolve", mock.Anything, mock.Anything).Return(&AnyResource{}, nil)
+			dataLoader := NewStandardDataLoader(resourceResolver, NewYamlPropertySourceLoader())
+
+			// when
+			data, err := dataLoader.Load(context.Background(), tc.location, tc.profiles...)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(data))
+			for _, item := range data {
+				names = append(names, item.PropertySource().Name())
+			}
+
+			assert.Equal(t, tc.wantNames, names)
+		})
+	}
+}
+
+func TestStandardDataLoader_LoadRepeatedly(t *testing.T) {
+	testCases := []struct {
+		name           string
+		firstLocation  string
+		secondLocation string
+		secondProfiles []string
+		wantNames      []string
+		wantSkipped    []string
+		wantNotSkipped []string
+	}{
+		{
+			name:           "profiles of the same location",
+			firstLocation:  "resources/",
+			secondLocation: "resources/",
+			secondProfiles: []string{"dev"},
+			wantNames:      []string{"resources/db-dev.yaml"},
+			wantSkipped:    []string{"resources/procyon-dev.yaml"},
+			wantNotSkipped: []string{"resources/procyon.yml"},
+		},
+		{
+			name:           "profiles of another location",
+			firstLocation:  "resources/",
+			secondLocation: "optional:other/",
+			secondProfiles: []string{"dev"},
+			wantNames:      []string{},
+			wantSkipped:    []string{"other/procyon-dev.yaml"},
+			wantNotSkipped: []string{"resources/procyon.yml", "resources/procyon-dev.yaml"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			files := map[string]string{
+				"resources/procyon.yaml": "procyon.config.import: db.yaml\n",
+				"resources/db.yaml":      "db.url: localhost\n",
+				"resources/db-dev.yaml":  "db.url: dev-host\n",
+			}
+
+			resourceResolver := &AnyResourceResolver{}
+			for location, contents := range files {
+				file := &FakeFile{contents: contents}
+				resourceResolver.On("Resolve", mock.Anything, location).
+					Run(func(mock.Arguments) { file.Reset() }).
+					Return(&AnyResource{location: location, exists: true, reader: file}, nil)
+				resourceResolver.On("Resolve", mock.Anything, path.Dir(location)+"/.").
+					Return(&AnyResource{exists: true}, nil)
+			}
+
+			resourceResolver.On("Resolve", mock.Anything, mock.Anything).Return(&AnyResource{}, nil)
+			dataLoader := NewStandardDataLoader(resourceResolver, NewYamlPropertySourceLoader())
+
+			_, err := dataLoader.Load(context.Background(), tc.firstLocation)
+			require.NoError(t, err)
+
+			// when
+			data, err := dataLoader.Load(context.Background(), tc.secondLocation, tc.secondProfiles...)
+
+			// then
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(data))
+			for _, item := range data {
+				names = append(names, item.PropertySource().Name())
+			}
+
+			assert.Equal(t, tc.wantNames, names)
+
+			skipped := dataLoader.SkippedLocations()
+			for _, location := range tc.wantSkipped {
+				assert.Contains(t, skipped, location)
+			}
+
+			for _, location := range tc.wantNotSkipped {
+				assert.NotContains(t, skipped, location)
+			}
+		})
+	}
+}
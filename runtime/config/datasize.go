@@ -0,0 +1,98 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DataSize represents a size of data in bytes. It can be bound from values like 512B, 10KB,
+// 64MB, 2GB or 1TB, where the units are based on 1024 and a value without a unit is in bytes.
+type DataSize int64
+
+// Common data sizes.
+const (
+	Byte     DataSize = 1
+	Kilobyte          = 1024 * Byte
+	Megabyte          = 1024 * Kilobyte
+	Gigabyte          = 1024 * Megabyte
+	Terabyte          = 1024 * Gigabyte
+)
+
+// dataSizeUnits holds the supported units from the largest to the smallest.
+var dataSizeUnits = []struct {
+	suffix string
+	size   DataSize
+}{
+	{"TB", Terabyte},
+	{"GB", Gigabyte},
+	{"MB", Megabyte},
+	{"KB", Kilobyte},
+	{"B", Byte},
+}
+
+// ParseDataSize parses a data size like 10MB. The unit is case-insensitive.
+func ParseDataSize(s string) (DataSize, error) {
+	str := strings.ToUpper(strings.TrimSpace(s))
+
+	unit := Byte
+	for _, u := range dataSizeUnits {
+		if number, ok := strings.CutSuffix(str, u.suffix); ok {
+			str, unit = strings.TrimSpace(number), u.size
+			break
+		}
+	}
+
+	value, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid data size %q", s)
+	}
+
+	size := DataSize(value) * unit
+	if size/unit != DataSize(value) {
+		return 0, fmt.Errorf("data size %q overflows", s)
+	}
+
+	return size, nil
+}
+
+// Bytes returns the size in bytes.
+func (s DataSize) Bytes() int64 {
+	return int64(s)
+}
+
+// String returns the size with the largest unit that represents it exactly.
+func (s DataSize) String() string {
+	for _, u := range dataSizeUnits {
+		if s != 0 && s%u.size == 0 {
+			return strconv.FormatInt(int64(s/u.size), 10) + u.suffix
+		}
+	}
+
+	return strconv.FormatInt(int64(s), 10) + "B"
+}
+
+// UnmarshalText parses the data size from the given text.
+func (s *DataSize) UnmarshalText(text []byte) error {
+	size, err := ParseDataSize(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = size
+	return nil
+}
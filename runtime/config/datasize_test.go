@@ -0,0 +1,113 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDataSize(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		wantSize DataSize
+		wantErr  error
+	}{
+		{
+			name:     "without unit",
+			value:    "1024",
+			wantSize: Kilobyte,
+		},
+		{
+			name:     "bytes",
+			value:    "512B",
+			wantSize: 512 * Byte,
+		},
+		{
+			name:     "lower case unit",
+			value:    "10mb",
+			wantSize: 10 * Megabyte,
+		},
+		{
+			name:     "unit with space",
+			value:    " 2 GB ",
+			wantSize: 2 * Gigabyte,
+		},
+		{
+			name:     "terabytes",
+			value:    "1TB",
+			wantSize: Terabyte,
+		},
+		{
+			name:    "invalid number",
+			value:   "tenKB",
+			wantErr: errors.New("invalid data size \"tenKB\""),
+		},
+		{
+			name:    "overflow",
+			value:   "9999999999TB",
+			wantErr: errors.New("data size \"9999999999TB\" overflows"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+
+			// when
+			size, err := ParseDataSize(tc.value)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantSize, size)
+		})
+	}
+}
+
+func TestDataSize_String(t *testing.T) {
+	testCases := []struct {
+		name       string
+		size       DataSize
+		wantString string
+	}{
+		{name: "zero", size: 0, wantString: "0B"},
+		{name: "bytes", size: 1000 * Byte, wantString: "1000B"},
+		{name: "kilobytes", size: 3 * Kilobyte, wantString: "3KB"},
+		{name: "megabytes", size: 1536 * Kilobyte, wantString: "1536KB"},
+		{name: "gigabytes", size: 4 * Gigabyte, wantString: "4GB"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+
+			// when
+			result := tc.size.String()
+
+			// then
+			assert.Equal(t, tc.wantString, result)
+			assert.Equal(t, int64(tc.size), tc.size.Bytes())
+		})
+	}
+}
@@ -0,0 +1,421 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"fmt"
+	stdio "io"
+	"os"
+	"strings"
+
+	"codnect.io/procyon/io"
+)
+
+// DotenvPropertySourceLoader struct is an implementation of the PropertySourceLoader interface for .env
+// contents. It loads the procyon.env and procyon-<profile>.env files in the configuration locations like
+// the other configuration files. The .env file in the working directory is loaded by the application
+// separately, with a precedence right below the environment variables.
+type DotenvPropertySourceLoader struct {
+}
+
+// NewDotenvPropertySourceLoader function creates a new DotenvPropertySourceLoader.
+func NewDotenvPropertySourceLoader() *DotenvPropertySourceLoader {
+	return &DotenvPropertySourceLoader{}
+}
+
+// Extensions method returns the file extensions supported by the DotenvPropertySourceLoader.
+func (l *DotenvPropertySourceLoader) Extensions() []string {
+	return []string{"env"}
+}
+
+// Load method loads a config source from a resource. Each variable is given as NAME=value, optionally
+// prefixed with export. Single-quoted values are taken literally, double-quoted values support escapes
+// and may span multiple lines, and unquoted values end at a comment. Double-quoted and unquoted values
+// are interpolated: $NAME, ${NAME} and ${NAME:-default} are replaced by the variables defined earlier
+// in the file or by the environment variables.
+func (l *DotenvPropertySourceLoader) Load(ctx context.Context, name string, resource io.Resource) (PropertySource, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("nil context")
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("empty source name")
+	}
+
+	if resource == nil {
+		return nil, fmt.Errorf("nil resource")
+	}
+
+	reader, err := resource.Reader()
+	if err != nil {
+		return nil, fmt.Errorf("load dotenv property source %q: %w", name, err)
+	}
+	defer reader.Close()
+
+	var data []byte
+	data, err = stdio.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("load dotenv property source %q: %w", name, err)
+	}
+
+	variables, origins, err := parseDotenv(string(data), resource.Location())
+	if err != nil {
+		return nil, fmt.Errorf("load dotenv property source %q: %w", name, err)
+	}
+
+	propSource := NewDotenvPropertySource(name, variables)
+	propSource.origins = origins
+	return propSource, nil
+}
+
+// DotenvPropertySource struct represents a source of the variables loaded from a .env file. Like the
+// environment variables, a property such as server.port is looked up as SERVER_PORT as well.
+type DotenvPropertySource struct {
+	name      string
+	variables map[string]string
+	origins   map[string]PropertyOrigin
+}
+
+// NewDotenvPropertySource function creates a new DotenvPropertySource with the given name and variables.
+func NewDotenvPropertySource(name string, variables map[string]string) *DotenvPropertySource {
+	if strings.TrimSpace(name) == "" {
+		panic("empty or blank name")
+	}
+
+	if variables == nil {
+		panic("nil map")
+	}
+
+	copied := make(map[string]string, len(variables))
+	for key, value := range variables {
+		copied[key] = value
+	}
+
+	return &DotenvPropertySource{
+		name:      name,
+		variables: copied,
+		origins:   make(map[string]PropertyOrigin),
+	}
+}
+
+// Name method returns the name of the source.
+func (s *DotenvPropertySource) Name() string {
+	return s.name
+}
+
+// Origin returns the origin of the property source.
+func (s *DotenvPropertySource) Origin() string {
+	return s.name
+}
+
+// Value returns the value of the variable matching the given property name from the source.
+// If the variable does not exist, it returns false.
+func (s *DotenvPropertySource) Value(propertyName string) (any, bool) {
+	for _, name := range variableNames(propertyName) {
+		if value, ok := s.variables[name]; ok {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+// ValueOrDefault returns the value of the variable matching the given property name from the source.
+// If the variable does not exist, it returns the default value.
+func (s *DotenvPropertySource) ValueOrDefault(propertyName string, defaultValue any) any {
+	value, ok := s.Value(propertyName)
+	if !ok {
+		return defaultValue
+	}
+
+	return value
+}
+
+// PropertyOrigin returns the origin of the variable matching the given property name, if the source is
+// loaded from a resource. If the variable does not exist, it returns false.
+func (s *DotenvPropertySource) PropertyOrigin(propertyName string) (PropertyOrigin, bool) {
+	for _, name := range variableNames(propertyName) {
+		if _, ok := s.variables[name]; ok {
+			origin, exists := s.origins[name]
+			return origin, exists
+		}
+	}
+
+	return PropertyOrigin{}, false
+}
+
+// PropertyNames returns the variable names in the source.
+func (s *DotenvPropertySource) PropertyNames() []string {
+	names := make([]string, 0, len(s.variables))
+
+	for name := range s.variables {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// variableNames returns the variable names the given property name is looked up with, in order: the
+// name itself, then its lower-case and upper-case forms with the dots and hyphens replaced by underscores.
+func variableNames(propertyName string) []string {
+	underscored := strings.NewReplacer(".", "_", "-", "_").Replace(propertyName)
+
+	return []string{
+		propertyName,
+		strings.ToLower(underscored),
+		strings.ToUpper(underscored),
+	}
+}
+
+// parseDotenv parses the given .env contents into a map of variables, and a map of their origins
+// in the given resource.
+func parseDotenv(data string, resource string) (map[string]string, map[string]PropertyOrigin, error) {
+	variables := make(map[string]string)
+	origins := make(map[string]PropertyOrigin)
+	lookup := func(name string) (string, bool) {
+		if value, ok := variables[name]; ok {
+			return value, true
+		}
+
+		return os.LookupEnv(name)
+	}
+
+	lines := strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n")
+
+	for index := 0; index < len(lines); index++ {
+		lineNumber := index + 1
+		line := strings.TrimSpace(lines[index])
+
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		if rest, ok := strings.CutPrefix(line, "export "); ok {
+			line = strings.TrimSpace(rest)
+		}
+
+		column := strings.Index(lines[index], line) + 1
+
+		name, value, found := strings.Cut(line, "=")
+		name = strings.TrimSpace(name)
+
+		if !found {
+			return nil, nil, fmt.Errorf("line %d: missing '=' in '%s'", lineNumber, line)
+		}
+
+		if !isVariableName(name) {
+			return nil, nil, fmt.Errorf("line %d: invalid variable name '%s'", lineNumber, name)
+		}
+
+		value = strings.TrimLeft(value, " \t")
+
+		switch {
+		case strings.HasPrefix(value, "'") || strings.HasPrefix(value, "\""):
+			quote := value[0]
+
+			// a quoted value may span multiple lines until its closing quote
+			quoted, rest, closed := cutQuoted(value[1:], quote)
+			for !closed && index+1 < len(lines) {
+				index++
+				value += "\n" + lines[index]
+				quoted, rest, closed = cutQuoted(value[1:], quote)
+			}
+
+			if !closed {
+				return nil, nil, fmt.Errorf("line %d: unterminated quoted value of '%s'", lineNumber, name)
+			}
+
+			if rest = strings.TrimSpace(rest); rest != "" && rest[0] != '#' {
+				return nil, nil, fmt.Errorf("line %d: unexpected '%s' after quoted value of '%s'", lineNumber, rest, name)
+			}
+
+			origins[name] = PropertyOrigin{Resource: resource, Line: lineNumber, Column: column}
+
+			if quote == '\'' {
+				variables[name] = quoted
+				continue
+			}
+
+			interpolated, err := interpolateDotenv(quoted, true, lookup)
+			if err != nil {
+				return nil, nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+
+			variables[name] = interpolated
+		default:
+			if commentIndex := strings.Index(value, " #"); commentIndex != -1 {
+				value = value[:commentIndex]
+			}
+
+			interpolated, err := interpolateDotenv(strings.TrimSpace(value), false, lookup)
+			if err != nil {
+				return nil, nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+
+			origins[name] = PropertyOrigin{Resource: resource, Line: lineNumber, Column: column}
+			variables[name] = interpolated
+		}
+	}
+
+	return variables, origins, nil
+}
+
+// cutQuoted returns the quoted part of the given value up to the closing quote, which may be escaped
+// with a backslash in a double-quoted value, and the rest after the closing quote.
+func cutQuoted(value string, quote byte) (string, string, bool) {
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && quote == '"' {
+			i++
+			continue
+		}
+
+		if value[i] == quote {
+			return value[:i], value[i+1:], true
+		}
+	}
+
+	return "", "", false
+}
+
+// interpolateDotenv replaces the $NAME, ${NAME} and ${NAME:-default} references of the given value using
+// the given lookup function. A reference to an undefined variable without a default is replaced by an
+// empty string, and an escaped \$ is kept as a dollar sign. If escapes is true, as for a double-quoted
+// value, the \n, \t and \r escapes are replaced too, and a backslash escapes any other character. The
+// escapes are replaced in the same pass, so that an escaped backslash does not escape a following '$'.
+func interpolateDotenv(value string, escapes bool, lookup func(name string) (string, bool)) (string, error) {
+	if !strings.Contains(value, "$") && (!escapes || !strings.Contains(value, "\\")) {
+		return value, nil
+	}
+
+	var sb strings.Builder
+
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+
+		if c == '\\' && i+1 < len(value) && (escapes || value[i+1] == '$') {
+			i++
+			sb.WriteString(unescapeDotenv(value[i]))
+			continue
+		}
+
+		if c != '$' || i+1 == len(value) {
+			sb.WriteByte(c)
+			continue
+		}
+
+		if value[i+1] == '{' {
+			end := strings.IndexByte(value[i+2:], '}')
+			if end == -1 {
+				return "", fmt.Errorf("unterminated variable reference '%s'", value[i:])
+			}
+
+			reference := value[i+2 : i+2+end]
+			name, defaultValue, hasDefault := strings.Cut(reference, ":-")
+
+			if !isVariableName(name) {
+				return "", fmt.Errorf("invalid variable reference '${%s}'", reference)
+			}
+
+			if resolved, ok := lookup(name); ok && (resolved != "" || !hasDefault) {
+				sb.WriteString(resolved)
+			} else if escapes {
+				sb.WriteString(unescapeDotenvDefault(defaultValue))
+			} else {
+				sb.WriteString(defaultValue)
+			}
+
+			i += end + 2
+			continue
+		}
+
+		end := i + 1
+		// a bare reference ends at a dot, so that a sentence such as "at $HOME." is interpolated as expected
+		for end < len(value) && value[end] != '.' && isVariableNameChar(value[end], end == i+1) {
+			end++
+		}
+
+		if end == i+1 {
+			sb.WriteByte(c)
+			continue
+		}
+
+		resolved, _ := lookup(value[i+1 : end])
+		sb.WriteString(resolved)
+		i = end - 1
+	}
+
+	return sb.String(), nil
+}
+
+// unescapeDotenv returns the character escaped by a backslash in a double-quoted value.
+func unescapeDotenv(c byte) string {
+	switch c {
+	case 'n':
+		return "\n"
+	case 't':
+		return "\t"
+	case 'r':
+		return "\r"
+	default:
+		return string(c)
+	}
+}
+
+// unescapeDotenvDefault replaces the escapes of the default value of a reference in a double-quoted value.
+// The default value is not interpolated.
+func unescapeDotenvDefault(value string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) {
+			i++
+			sb.WriteString(unescapeDotenv(value[i]))
+			continue
+		}
+
+		sb.WriteByte(value[i])
+	}
+
+	return sb.String()
+}
+
+// isVariableName reports whether the given name is a valid variable name.
+func isVariableName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for i := 0; i < len(name); i++ {
+		if !isVariableNameChar(name[i], i == 0) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isVariableNameChar reports whether the given character is allowed in a variable name. Variable names
+// consist of letters, digits, underscores and dots, and do not start with a digit.
+func isVariableNameChar(c byte, first bool) bool {
+	switch {
+	case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+		return true
+	case c >= '0' && c <= '9' || c == '.':
+		return !first
+	}
+
+	return false
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"codnect.io/procyon/io"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDotenvPropertySourceLoader_Extensions(t *testing.T) {
+	// given
+	loader := NewDotenvPropertySourceLoader()
+
+	// when
+	extensions := loader.Extensions()
+
+	// then
+	assert.Equal(t, []string{"env"}, extensions)
+}
+
+func TestDotenvPropertySourceLoader_Load(t *testing.T) {
+	t.Setenv("PROCYON_TEST_HOME", "/home/procyon")
+
+	testCases := []struct {
+		name          string
+		ctx           context.Context
+		sourceName    string
+		resource      io.Resource
+		wantErr       error
+		wantVariables map[string]string
+	}{
+		{
+			name:       "nil context",
+			ctx:        nil,
+			sourceName: "anySourceName",
+			wantErr:    errors.New("nil context"),
+		},
+		{
+			name:       "empty source name",
+			ctx:        context.Background(),
+			sourceName: "",
+			resource:   io.NewFileResource("resources/procyon.env"),
+			wantErr:    errors.New("empty source name"),
+		},
+		{
+			name:       "nil resource",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			wantErr:    errors.New("nil resource"),
+		},
+		{
+			name:       "reader error",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource:   &AnyResource{err: errors.New("reader error")},
+			wantErr:    errors.New("load dotenv property source \"anySourceName\": reader error"),
+		},
+		{
+			name:       "missing separator",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource:   &AnyResource{reader: &FakeFile{contents: "A=1\nINVALID"}},
+			wantErr:    errors.New("load dotenv property source \"anySourceName\": line 2: missing '=' in 'INVALID'"),
+		},
+		{
+			name:       "invalid variable name",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource:   &AnyResource{reader: &FakeFile{contents: "1A=1"}},
+			wantErr:    errors.New("load dotenv property source \"anySourceName\": line 1: invalid variable name '1A'"),
+		},
+		{
+			name:       "unterminated quoted value",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource:   &AnyResource{reader: &FakeFile{contents: "A=\"value\nB=2"}},
+			wantErr:    errors.New("load dotenv property source \"anySourceName\": line 1: unterminated quoted value of 'A'"),
+		},
+		{
+			name:       "unexpected content after quoted value",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource:   &AnyResource{reader: &FakeFile{contents: "A='value' extra"}},
+			wantErr:    errors.New("load dotenv property source \"anySourceName\": line 1: unexpected 'extra' after quoted value of 'A'"),
+		},
+		{
+			name:       "unterminated variable reference",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource:   &AnyResource{reader: &FakeFile{contents: "A=${B"}},
+			wantErr:    errors.New("load dotenv property source \"anySourceName\": line 1: unterminated variable reference '${B'"),
+		},
+		{
+			name:       "unquoted values",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "# comment\n\nexport SERVER_PORT=8080\nAPP_NAME = demo app # comment\nURL=http://host/#anchor\nEMPTY=\n"},
+			},
+			wantVariables: map[string]string{
+				"SERVER_PORT": "8080",
+				"APP_NAME":    "demo app",
+				"URL":         "http://host/#anchor",
+				"EMPTY":       "",
+			},
+		},
+		{
+			name:       "quoted values",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "SINGLE='raw $HOME \\n' # comment\nDOUBLE=\"tab\\tquote\\\" \\$HOME\"\nMULTI=\"first\nsecond\"\n"},
+			},
+			wantVariables: map[string]string{
+				"SINGLE": "raw $HOME \\n",
+				"DOUBLE": "tab\tquote\" $HOME",
+				"MULTI":  "first\nsecond",
+			},
+		},
+		{
+			name:       "interpolation",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "HOST=localhost\nURL=http://${HOST}:${PORT:-8080}/$PROCYON_TEST_HOME.\nQUOTED=\"$HOST and ${UNDEFINED_VAR}\"\nPRICE=5$"},
+			},
+			wantVariables: map[string]string{
+				"HOST":   "localhost",
+				"URL":    "http://localhost:8080//home/procyon.",
+				"QUOTED": "localhost and ",
+				"PRICE":  "5$",
+			},
+		},
+		{
+			name:       "escapes and interpolation in one pass",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "BACKSLASH=\"\\\\$PROCYON_TEST_HOME\"\nESCAPED=\"\\\\\\$HOME\"\nDEFAULT=\"${UNDEFINED_VAR:-a\\tb}\"\nUNQUOTED=C:\\path\\$HOME_DIR\n"},
+			},
+			wantVariables: map[string]string{
+				"BACKSLASH": "\\/home/procyon",
+				"ESCAPED":   "\\$HOME",
+				"DEFAULT":   "a\tb",
+				"UNQUOTED":  "C:\\path$HOME_DIR",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			loader := NewDotenvPropertySourceLoader()
+
+			// when
+			source, err := loader.Load(tc.ctx, tc.sourceName, tc.resource)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, source)
+			assert.Equal(t, tc.sourceName, source.Name())
+			assert.Equal(t, tc.wantVariables, source.(*DotenvPropertySource).variables)
+		})
+	}
+}
+
+func TestDotenvPropertySourceLoader_LoadOrigins(t *testing.T) {
+	// given
+	loader := NewDotenvPropertySourceLoader()
+	resource := &AnyResource{
+		location: ".env",
+		reader:   &FakeFile{contents: "# comment\nSERVER_PORT=8080\n  export APP_NAME=\"demo\napp\"\nLOGGING_LEVEL_ROOT=debug"},
+	}
+
+	// when
+	source, err := loader.Load(context.Background(), "anySourceName", resource)
+
+	// then
+	require.NoError(t, err)
+
+	lookup, ok := source.(OriginLookup)
+	require.True(t, ok)
+
+	wantOrigins := map[string]string{
+		"server.port":        ".env:2:1",
+		"APP_NAME":           ".env:3:10",
+		"logging.level.root": ".env:5:1",
+	}
+
+	for name, wantOrigin := range wantOrigins {
+		origin, exists := lookup.PropertyOrigin(name)
+		require.True(t, exists, name)
+		assert.Equal(t, wantOrigin, origin.String(), name)
+	}
+
+	_, exists := lookup.PropertyOrigin("app.version")
+	assert.False(t, exists)
+}
+
+func TestDotenvPropertySource_Value(t *testing.T) {
+	// given
+	source := NewDotenvPropertySource("anySourceName", map[string]string{
+		"SERVER_PORT":         "8080",
+		"app.name":            "demo",
+		"logging_level_root":  "debug",
+		"SERVER_MAX_SIZE":     "10",
+		"server.max-size.min": "1",
+	})
+
+	testCases := []struct {
+		propertyName string
+		wantValue    any
+		wantOk       bool
+	}{
+		{propertyName: "server.port", wantValue: "8080", wantOk: true},
+		{propertyName: "SERVER_PORT", wantValue: "8080", wantOk: true},
+		{propertyName: "app.name", wantValue: "demo", wantOk: true},
+		{propertyName: "logging.level.root", wantValue: "debug", wantOk: true},
+		{propertyName: "server.max-size", wantValue: "10", wantOk: true},
+		{propertyName: "server.max-size.min", wantValue: "1", wantOk: true},
+		{propertyName: "app.version", wantOk: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.propertyName, func(t *testing.T) {
+			// when
+			value, ok := source.Value(tc.propertyName)
+
+			// then
+			assert.Equal(t, tc.wantOk, ok)
+			assert.Equal(t, tc.wantValue, value)
+		})
+	}
+
+	assert.Equal(t, "default", source.ValueOrDefault("app.version", "default"))
+	assert.ElementsMatch(t, []string{"SERVER_PORT", "app.name", "logging_level_root", "SERVER_MAX_SIZE", "server.max-size.min"},
+		source.PropertyNames())
+}
+
+func TestNewDotenvPropertySource(t *testing.T) {
+	assert.PanicsWithValue(t, "empty or blank name", func() {
+		NewDotenvPropertySource(" ", map[string]string{})
+	})
+
+	assert.PanicsWithValue(t, "nil map", func() {
+		NewDotenvPropertySource("anySourceName", nil)
+	})
+}
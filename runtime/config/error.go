@@ -16,8 +16,103 @@ package config
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 )
 
 var (
 	ErrPropertyNotFound = errors.New("property not found")
 )
+
+// MissingPropertyError is returned when a required property cannot be found while binding.
+type MissingPropertyError struct {
+	// Name is the name of the missing property.
+	Name string
+}
+
+// Error returns the error message.
+func (e *MissingPropertyError) Error() string {
+	return "required"
+}
+
+// UnresolvedPlaceholderError is returned when a placeholder cannot be resolved.
+type UnresolvedPlaceholderError struct {
+	// Name is the property name referenced by the placeholder.
+	Name string
+}
+
+// Error returns the error message.
+func (e *UnresolvedPlaceholderError) Error() string {
+	return "unresolved placeholder ${" + e.Name + "}"
+}
+
+// CircularPlaceholderError is returned when placeholders reference each other in a cycle.
+type CircularPlaceholderError struct {
+	// Chain is the sequence of property names forming the cycle.
+	Chain []string
+}
+
+// Error returns the error message.
+func (e *CircularPlaceholderError) Error() string {
+	return "circular placeholder reference " + strings.Join(e.Chain, " -> ")
+}
+
+// CircularImportError is returned when configuration files import each other in a cycle.
+type CircularImportError struct {
+	// Chain is the sequence of configuration files forming the cycle.
+	Chain []string
+}
+
+// Error returns the error message.
+func (e *CircularImportError) Error() string {
+	return "circular config import " + strings.Join(e.Chain, " -> ")
+}
+
+// PropertyViolation describes a bound property that does not satisfy a constraint.
+type PropertyViolation struct {
+	// Name is the name of the property.
+	Name string
+	// Value is the bound value of the property. It is nil for the violations reported by a Validatable.
+	Value any
+	// Origin is the origin of the property value. It is empty if the value is not set by a property source.
+	Origin string
+	// Message describes the violated constraint.
+	Message string
+}
+
+// String returns the violation in a human-readable form.
+func (v PropertyViolation) String() string {
+	var sb strings.Builder
+	sb.WriteString(v.Name)
+	sb.WriteString(": ")
+	sb.WriteString(v.Message)
+
+	if v.Value != nil {
+		sb.WriteString(fmt.Sprintf(", but was '%v'", v.Value))
+	}
+
+	if v.Origin != "" {
+		sb.WriteString(" (from ")
+		sb.WriteString(v.Origin)
+		sb.WriteString(")")
+	}
+
+	return sb.String()
+}
+
+// ValidationError is returned when bound properties do not satisfy their constraints.
+// It lists every invalid property.
+type ValidationError struct {
+	// Violations are the constraint violations.
+	Violations []PropertyViolation
+}
+
+// Error returns the error message.
+func (e *ValidationError) Error() string {
+	violations := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		violations = append(violations, violation.String())
+	}
+
+	return "invalid properties: " + strings.Join(violations, "; ")
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	stdio "io"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"codnect.io/procyon/io"
+)
+
+// JsonPropertySourceLoader struct is an implementation of the PropertySourceLoader interface for JSON contents.
+type JsonPropertySourceLoader struct {
+}
+
+// NewJsonPropertySourceLoader function creates a new JsonPropertySourceLoader.
+func NewJsonPropertySourceLoader() *JsonPropertySourceLoader {
+	return &JsonPropertySourceLoader{}
+}
+
+// Extensions method returns the file extensions supported by the JsonPropertySourceLoader.
+func (l *JsonPropertySourceLoader) Extensions() []string {
+	return []string{"json"}
+}
+
+// Load method loads a config source from a resource. The contents must be a JSON object, which is flattened
+// into dot-separated keys with indexed list elements like the YAML contents. Integral numbers are loaded as
+// int values and the others as float64 values.
+func (l *JsonPropertySourceLoader) Load(ctx context.Context, name string, resource io.Resource) (PropertySource, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("nil context")
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("empty source name")
+	}
+
+	if resource == nil {
+		return nil, fmt.Errorf("nil resource")
+	}
+
+	reader, err := resource.Reader()
+	if err != nil {
+		return nil, fmt.Errorf("load json property source %q: %w", name, err)
+	}
+	defer reader.Close()
+
+	var data []byte
+	data, err = stdio.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("load json property source %q: %w", name, err)
+	}
+
+	loaded := make(map[string]any)
+
+	if len(bytes.TrimSpace(data)) != 0 {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+
+		if err = decoder.Decode(&loaded); err != nil {
+			return nil, fmt.Errorf("load json property source %q: %w", name, jsonError(data, err))
+		}
+
+		if loaded == nil {
+			loaded = make(map[string]any)
+		}
+	}
+
+	return NewMapPropertySource(name, normalizeJsonNumbers(loaded).(map[string]any)), nil
+}
+
+// jsonError adds the line and column to the syntax and type errors of the given JSON contents.
+func jsonError(data []byte, err error) error {
+	var (
+		syntaxErr *json.SyntaxError
+		typeErr   *json.UnmarshalTypeError
+		offset    int64
+	)
+
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	case errors.Is(err, stdio.ErrUnexpectedEOF):
+		offset = int64(len(data)) + 1
+		err = errors.New("unexpected end of JSON input")
+	default:
+		return err
+	}
+
+	line, column := position(data, int(offset))
+	return fmt.Errorf("line %d, column %d: %w", line, column, err)
+}
+
+// position returns the line and the column of the character at the given offset minus one of the given
+// contents, both starting at 1. The end of the contents is at offset len(data)+1.
+func position(data []byte, offset int) (int, int) {
+	offset = min(max(offset, 1), len(data)+1)
+	before := data[:offset-1]
+
+	line := bytes.Count(before, []byte("\n")) + 1
+	lineStart := bytes.LastIndexByte(before, '\n') + 1
+	column := utf8.RuneCount(before[lineStart:]) + 1
+
+	return line, column
+}
+
+// normalizeJsonNumbers replaces the json.Number values of the given decoded value with int or float64 values.
+func normalizeJsonNumbers(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, element := range v {
+			v[key] = normalizeJsonNumbers(element)
+		}
+
+		return v
+	case []any:
+		for index, element := range v {
+			v[index] = normalizeJsonNumbers(element)
+		}
+
+		return v
+	case json.Number:
+		if !strings.ContainsAny(v.String(), ".eE") {
+			if i, err := v.Int64(); err == nil && i >= math.MinInt && i <= math.MaxInt {
+				return int(i)
+			}
+		}
+
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+
+		return v.String()
+	default:
+		return value
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"codnect.io/procyon/io"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonPropertySourceLoader_Extensions(t *testing.T) {
+	// given
+	loader := NewJsonPropertySourceLoader()
+
+	// when
+	extensions := loader.Extensions()
+
+	// then
+	assert.Equal(t, []string{"json"}, extensions)
+}
+
+func TestJsonPropertySourceLoader_Load(t *testing.T) {
+	testCases := []struct {
+		name           string
+		ctx            context.Context
+		sourceName     string
+		resource       io.Resource
+		wantErr        error
+		wantProperties map[string]any
+	}{
+		{
+			name:       "nil context",
+			ctx:        nil,
+			sourceName: "anySourceName",
+			wantErr:    errors.New("nil context"),
+		},
+		{
+			name:       "empty source name",
+			ctx:        context.Background(),
+			sourceName: "",
+			resource:   io.NewFileResource("resources/procyon.json"),
+			wantErr:    errors.New("empty source name"),
+		},
+		{
+			name:       "nil resource",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			wantErr:    errors.New("nil resource"),
+		},
+		{
+			name:       "reader error",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource:   &AnyResource{err: errors.New("reader error")},
+			wantErr:    errors.New("load json property source \"anySourceName\": reader error"),
+		},
+		{
+			name:       "invalid json",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "{\n  \"server\": {\n    \"port\": 8080,\n  }\n}"},
+			},
+			wantErr: errors.New("load json property source \"anySourceName\": line 4, column 3: invalid character '}' looking for beginning of object key string"),
+		},
+		{
+			name:       "unexpected end of input",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "{\n  \"server\": {"},
+			},
+			wantErr: errors.New("load json property source \"anySourceName\": line 2, column 14: unexpected end of JSON input"),
+		},
+		{
+			name:       "not an object",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "[1, 2]"},
+			},
+			wantErr: errors.New("load json property source \"anySourceName\": line 1, column 1: json: cannot unmarshal array into Go value of type map[string]interface {}"),
+		},
+		{
+			name:       "empty contents",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "  \n"},
+			},
+			wantProperties: map[string]any{},
+		},
+		{
+			name:       "null contents",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "null"},
+			},
+			wantProperties: map[string]any{},
+		},
+		{
+			name:       "valid json",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: `{
+  "server": {"port": 8080, "ratio": 0.75, "big": 1e3, "enabled": true, "name": null},
+  "hosts": [{"name": "a"}, {"name": "b", "tags": ["x", "y"]}]
+}`},
+			},
+			wantProperties: map[string]any{
+				"server.port":      8080,
+				"server.ratio":     0.75,
+				"server.big":       1000.0,
+				"server.enabled":   true,
+				"server.name":      nil,
+				"hosts[0].name":    "a",
+				"hosts[1].name":    "b",
+				"hosts[1].tags[0]": "x",
+				"hosts[1].tags[1]": "y",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			loader := NewJsonPropertySourceLoader()
+
+			// when
+			source, err := loader.Load(tc.ctx, tc.sourceName, tc.resource)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, source)
+			assert.Equal(t, tc.sourceName, source.Name())
+			assert.ElementsMatch(t, mapKeys(tc.wantProperties), source.PropertyNames())
+
+			for wantKey, wantValue := range tc.wantProperties {
+				value, ok := source.Value(wantKey)
+				assert.True(t, ok, wantKey)
+				assert.Equal(t, wantValue, value, wantKey)
+			}
+		})
+	}
+}
@@ -15,7 +15,9 @@
 package config
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	stdio "io"
 
@@ -28,6 +30,14 @@ type PropertySourceLoader interface {
 	Load(ctx context.Context, name string, resource io.Resource) (PropertySource, error)
 }
 
+// MultiDocumentPropertySourceLoader can be implemented by property source loaders whose resources may contain
+// multiple documents. Each document is loaded as a separate property source.
+type MultiDocumentPropertySourceLoader interface {
+	PropertySourceLoader
+	// LoadDocuments loads a property source for each document of the resource, in their order.
+	LoadDocuments(ctx context.Context, name string, resource io.Resource) ([]PropertySource, error)
+}
+
 // YamlPropertySourceLoader struct is an implementation of the PropertySourceLoader interface for YAML contents.
 type YamlPropertySourceLoader struct {
 }
@@ -42,8 +52,21 @@ func (l *YamlPropertySourceLoader) Extensions() []string {
 	return []string{"yaml", "yml"}
 }
 
-// Load method loads a config source from a resource.
+// Load method loads a config source from a resource. If the resource contains multiple documents, only
+// the first one is loaded, see LoadDocuments.
 func (l *YamlPropertySourceLoader) Load(ctx context.Context, name string, resource io.Resource) (PropertySource, error) {
+	propSources, err := l.LoadDocuments(ctx, name, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	return propSources[0], nil
+}
+
+// LoadDocuments method loads a config source for each document of a resource. The documents are separated
+// by '---' lines, and the empty ones are skipped. The first document is named after the given name, the
+// following ones are named after their index, such as "name (document #1)".
+func (l *YamlPropertySourceLoader) LoadDocuments(ctx context.Context, name string, resource io.Resource) ([]PropertySource, error) {
 	if ctx == nil {
 		return nil, fmt.Errorf("nil context")
 	}
@@ -56,8 +79,6 @@ func (l *YamlPropertySourceLoader) Load(ctx context.Context, name string, resour
 		return nil, fmt.Errorf("nil resource")
 	}
 
-	loaded := make(map[string]any)
-
 	reader, err := resource.Reader()
 	if err != nil {
 		return nil, fmt.Errorf("load yaml property source %q: %w", name, err)
@@ -69,10 +90,78 @@ func (l *YamlPropertySourceLoader) Load(ctx context.Context, name string, resour
 		return nil, fmt.Errorf("load yaml property source %q: %w", name, err)
 	}
 
-	err = yaml.Unmarshal(data, &loaded)
-	if err != nil {
-		return nil, fmt.Errorf("load yaml property source %q: %w", name, err)
+	propSources := make([]PropertySource, 0, 1)
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+
+	for index := 0; ; index++ {
+		var node yaml.Node
+
+		err = decoder.Decode(&node)
+		if errors.Is(err, stdio.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("load yaml property source %q: %w", name, err)
+		}
+
+		loaded := make(map[string]any)
+		if node.Kind != 0 {
+			if err = node.Decode(&loaded); err != nil {
+				return nil, fmt.Errorf("load yaml property source %q: %w", name, err)
+			}
+		}
+
+		if len(loaded) == 0 && index != 0 {
+			continue
+		}
+
+		if loaded == nil {
+			loaded = make(map[string]any)
+		}
+
+		docName := name
+		if index != 0 {
+			docName = fmt.Sprintf("%s (document #%d)", name, index)
+		}
+
+		origins := make(map[string]PropertyOrigin)
+		yamlOrigins(origins, resource.Location(), "", &node)
+
+		propSources = append(propSources, NewOriginTrackedMapPropertySource(docName, loaded, origins))
 	}
 
-	return NewMapPropertySource(name, loaded), nil
+	if len(propSources) == 0 {
+		propSources = append(propSources, NewMapPropertySource(name, map[string]any{}))
+	}
+
+	return propSources, nil
+}
+
+// yamlOrigins collects the origins of the properties in the given node, using the same property names
+// as flatMap. The origin of a property is the position of its key, or of the element for list elements.
+func yamlOrigins(dst map[string]PropertyOrigin, resource string, prefix string, node *yaml.Node) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			yamlOrigins(dst, resource, prefix, child)
+		}
+	case yaml.AliasNode:
+		yamlOrigins(dst, resource, prefix, node.Alias)
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keyNode, valueNode := node.Content[i], node.Content[i+1]
+			name := join(prefix, keyNode.Value)
+
+			dst[name] = PropertyOrigin{Resource: resource, Line: keyNode.Line, Column: keyNode.Column}
+			yamlOrigins(dst, resource, name, valueNode)
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			name := fmt.Sprintf("%s[%d]", prefix, i)
+
+			dst[name] = PropertyOrigin{Resource: resource, Line: child.Line, Column: child.Column}
+			yamlOrigins(dst, resource, name, child)
+		}
+	}
 }
@@ -31,6 +31,7 @@ type FakeFile struct {
 	offset   int
 	readErr  error
 	fileInfo fs.FileInfo
+	closed   bool
 }
 
 func (f *FakeFile) Reset() *FakeFile {
@@ -55,6 +56,7 @@ func (f *FakeFile) Read(p []byte) (int, error) {
 }
 
 func (f *FakeFile) Close() error {
+	f.closed = true
 	return nil
 }
 
@@ -85,6 +87,68 @@ func (a *AnyResource) Reader() (stdio.ReadCloser, error) {
 	return a.reader, nil
 }
 
+func TestPropertySourceLoader_LoadClosesReader(t *testing.T) {
+	testCases := []struct {
+		name     string
+		loader   PropertySourceLoader
+		contents string
+	}{
+		{
+			name:     "properties",
+			loader:   NewPropertiesPropertySourceLoader(),
+			contents: "app.name=demo",
+		},
+		{
+			name:     "properties with error",
+			loader:   NewPropertiesPropertySourceLoader(),
+			contents: "app.name=\\uZZZZ",
+		},
+		{
+			name:     "dotenv",
+			loader:   NewDotenvPropertySourceLoader(),
+			contents: "APP_NAME=demo",
+		},
+		{
+			name:     "dotenv with error",
+			loader:   NewDotenvPropertySourceLoader(),
+			contents: "APP NAME=demo",
+		},
+		{
+			name:     "json",
+			loader:   NewJsonPropertySourceLoader(),
+			contents: `{"app": {"name": "demo"}}`,
+		},
+		{
+			name:     "json with error",
+			loader:   NewJsonPropertySourceLoader(),
+			contents: `{"app":`,
+		},
+		{
+			name:     "toml",
+			loader:   NewTomlPropertySourceLoader(),
+			contents: "[app]\nname = \"demo\"",
+		},
+		{
+			name:     "toml with error",
+			loader:   NewTomlPropertySourceLoader(),
+			contents: "[app",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			file := &FakeFile{contents: tc.contents}
+
+			// when
+			_, _ = tc.loader.Load(context.Background(), "anySourceName", &AnyResource{reader: file})
+
+			// then
+			assert.True(t, file.closed)
+		})
+	}
+}
+
 func TestYamlPropertySourceLoader_Extensions(t *testing.T) {
 	// given
 	loader := NewYamlPropertySourceLoader()
@@ -218,3 +282,114 @@ func TestYamlPropertySourceLoader_Load(t *testing.T) {
 		})
 	}
 }
+
+func TestYamlPropertySourceLoader_LoadDocuments(t *testing.T) {
+	testCases := []struct {
+		name          string
+		contents      string
+		wantNames     []string
+		wantDocuments []map[string]any
+		wantErr       error
+	}{
+		{
+			name:          "empty",
+			contents:      "",
+			wantNames:     []string{"anySourceName"},
+			wantDocuments: []map[string]any{{}},
+		},
+		{
+			name:          "single document",
+			contents:      "app:\n  name: demo",
+			wantNames:     []string{"anySourceName"},
+			wantDocuments: []map[string]any{{"app.name": "demo"}},
+		},
+		{
+			name:      "multiple documents",
+			contents:  "app:\n  name: demo\n---\n\n---\nprocyon.config.activate.on-profile: prod\napp:\n  name: demo-prod\n---\napp.port: 8080\n",
+			wantNames: []string{"anySourceName", "anySourceName (document #2)", "anySourceName (document #3)"},
+			wantDocuments: []map[string]any{
+				{"app.name": "demo"},
+				{"procyon.config.activate.on-profile": "prod", "app.name": "demo-prod"},
+				{"app.port": 8080},
+			},
+		},
+		{
+			name:     "invalid document",
+			contents: "app:\n  name: demo\n---\nversion 2.1\njobs:\n  image: 'nginx:latest'",
+			wantErr:  errors.New("load yaml property source \"anySourceName\": yaml: line 5: mapping values are not allowed in this context"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			loader := NewYamlPropertySourceLoader()
+			resource := &AnyResource{reader: &FakeFile{contents: tc.contents}}
+
+			// when
+			propSources, err := loader.LoadDocuments(context.Background(), "anySourceName", resource)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, propSources, len(tc.wantNames))
+
+			for index, propSource := range propSources {
+				assert.Equal(t, tc.wantNames[index], propSource.Name())
+				assert.ElementsMatch(t, mapKeys(tc.wantDocuments[index]), propSource.PropertyNames())
+
+				for wantKey, wantValue := range tc.wantDocuments[index] {
+					value, _ := propSource.Value(wantKey)
+					assert.Equal(t, wantValue, value)
+				}
+			}
+		})
+	}
+}
+
+func TestYamlPropertySourceLoader_LoadDocumentsOrigins(t *testing.T) {
+	// given
+	loader := NewYamlPropertySourceLoader()
+	resource := &AnyResource{
+		location: "resources/procyon.yaml",
+		reader: &FakeFile{
+			contents: "app:\n  name: demo\n  hosts:\n    - a\n    - b\n---\napp.port: 8080\n",
+		},
+	}
+
+	// when
+	propSources, err := loader.LoadDocuments(context.Background(), "anySourceName", resource)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, propSources, 2)
+
+	wantOrigins := []map[string]string{
+		{
+			"app.name":     "resources/procyon.yaml:2:3",
+			"app.hosts[0]": "resources/procyon.yaml:4:7",
+			"app.hosts[1]": "resources/procyon.yaml:5:7",
+		},
+		{
+			"app.port": "resources/procyon.yaml:7:1",
+		},
+	}
+
+	for index, propSource := range propSources {
+		lookup, ok := propSource.(OriginLookup)
+		require.True(t, ok)
+
+		for name, wantOrigin := range wantOrigins[index] {
+			origin, exists := lookup.PropertyOrigin(name)
+			require.True(t, exists, name)
+			assert.Equal(t, wantOrigin, origin.String(), name)
+		}
+
+		_, exists := lookup.PropertyOrigin("app")
+		assert.False(t, exists)
+	}
+}
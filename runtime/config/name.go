@@ -0,0 +1,363 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// PropertyName is a property name split into its elements, such as server, hosts, [0] and name for
+// server.hosts[0].name. Property names are matched in a relaxed way by comparing the canonical forms
+// of their elements, which are lower case without the '-' and '_' characters. So server.maxConnections,
+// server.max-connections, server.max_connections and SERVER.MAXCONNECTIONS are the same name.
+//
+// Names in the environment variable form, which are upper case and use '_' as separator, are also
+// supported. As '_' may separate the words of an element as well as the elements, the elements of such
+// a name are matched against any number of its consecutive words, and its numeric words are indices.
+// So SERVER_MAXCONNECTIONS and SERVER_MAX_CONNECTIONS match server.max-connections, and
+// SERVER_HOSTS_0_NAME matches server.hosts[0].name.
+type PropertyName struct {
+	elements []nameElement
+	envForm  bool
+}
+
+// nameElement is an element of a property name.
+type nameElement struct {
+	raw       string
+	canonical string
+	index     bool
+}
+
+// ParsePropertyName parses the given property name.
+func ParsePropertyName(name string) PropertyName {
+	if isEnvFormName(name) {
+		return parseEnvFormName(name)
+	}
+
+	elements := make([]nameElement, 0)
+	for _, part := range strings.Split(name, ".") {
+		key, rest, _ := strings.Cut(part, "[")
+		if key != "" {
+			elements = append(elements, nameElement{raw: key, canonical: canonicalElement(key)})
+		}
+
+		for rest != "" {
+			var index string
+			index, rest, _ = strings.Cut(rest, "]")
+			elements = append(elements, nameElement{raw: index, canonical: index, index: true})
+			rest = strings.TrimPrefix(rest, "[")
+		}
+	}
+
+	return PropertyName{elements: elements}
+}
+
+// parseEnvFormName parses a name in the environment variable form such as SERVER_HOSTS_0_NAME.
+func parseEnvFormName(name string) PropertyName {
+	elements := make([]nameElement, 0)
+	for _, word := range strings.Split(name, "_") {
+		if word == "" {
+			continue
+		}
+
+		word = strings.ToLower(word)
+		elements = append(elements, nameElement{raw: word, canonical: word, index: isNumeric(word)})
+	}
+
+	return PropertyName{elements: elements, envForm: true}
+}
+
+// String returns the canonical form of the name, such as server.hosts[0].maxconnections. The words of
+// a name in the environment variable form are taken as separate elements.
+func (n PropertyName) String() string {
+	var sb strings.Builder
+	for _, element := range n.elements {
+		if element.index {
+			sb.WriteString("[" + element.canonical + "]")
+			continue
+		}
+
+		if sb.Len() != 0 {
+			sb.WriteString(".")
+		}
+
+		sb.WriteString(element.canonical)
+	}
+
+	return sb.String()
+}
+
+// Matches reports whether the name is the same as the given name in a relaxed way.
+func (n PropertyName) Matches(other PropertyName) bool {
+	if n.envForm && !other.envForm {
+		return other.Matches(n)
+	}
+
+	consumed, ok := n.matchPrefix(other)
+	return ok && consumed == len(other.elements)
+}
+
+// IsAncestorOf reports whether the given name is a descendant of the name, such as server.hosts[0].name
+// is a descendant of server and server.hosts.
+func (n PropertyName) IsAncestorOf(other PropertyName) bool {
+	consumed, ok := n.matchPrefix(other)
+	return ok && consumed < len(other.elements)
+}
+
+// prefix returns the first count elements of the name as they are written, such as server.hosts[0]
+// for the first three elements of server.hosts[0].name. The name must not be in the environment
+// variable form, as the words of such a name are written in upper case.
+func (n PropertyName) prefix(count int) string {
+	var sb strings.Builder
+	for _, element := range n.elements[:count] {
+		if element.index {
+			sb.WriteString("[" + element.raw + "]")
+			continue
+		}
+
+		if sb.Len() != 0 {
+			sb.WriteString(".")
+		}
+
+		sb.WriteString(element.raw)
+	}
+
+	return sb.String()
+}
+
+// matchPrefix matches all the elements of the name against the leading elements of the given name.
+// It returns the number of elements of the given name matched.
+func (n PropertyName) matchPrefix(other PropertyName) (int, bool) {
+	switch {
+	case n.envForm == other.envForm:
+		if len(n.elements) > len(other.elements) {
+			return 0, false
+		}
+
+		for i, element := range n.elements {
+			if element.index != other.elements[i].index || element.canonical != other.elements[i].canonical {
+				return 0, false
+			}
+		}
+
+		return len(n.elements), true
+	case other.envForm:
+		return matchEnvWords(n.elements, other.elements)
+	default:
+		// the env form name is the prefix, it matches if its words form the leading elements of the other name
+		for count := 1; count <= len(other.elements); count++ {
+			consumed, ok := matchEnvWords(other.elements[:count], n.elements)
+			if ok && consumed == len(n.elements) {
+				return count, true
+			}
+		}
+
+		return 0, false
+	}
+}
+
+// matchEnvWords matches the given elements against the leading words of a name in the environment variable
+// form. An index element matches a numeric word, and any other element matches its consecutive words.
+// It returns the number of words matched.
+func matchEnvWords(elements []nameElement, words []nameElement) (int, bool) {
+	consumed := 0
+
+	for _, element := range elements {
+		if consumed >= len(words) {
+			return 0, false
+		}
+
+		if element.index {
+			if !words[consumed].index || words[consumed].canonical != element.canonical {
+				return 0, false
+			}
+
+			consumed++
+			continue
+		}
+
+		joined := ""
+		for consumed < len(words) && len(joined) < len(element.canonical) {
+			joined += words[consumed].canonical
+			consumed++
+		}
+
+		if joined != element.canonical {
+			return 0, false
+		}
+	}
+
+	return consumed, true
+}
+
+// findProperty returns the source holding the given property, along with the name of the property
+// in the source. The sources are searched in order, and the property is looked up by its exact name
+// first, then by the names in the source that match it in a relaxed way.
+func findProperty(propSources *PropertySources, name string) (PropertySource, string, bool) {
+	return searchProperty(propSources, name, false)
+}
+
+// findDescendant returns the source holding the given property or any of its descendants, along with
+// the name of the property or its first descendant in the source.
+func findDescendant(propSources *PropertySources, name string) (PropertySource, string, bool) {
+	return searchProperty(propSources, name, true)
+}
+
+// searchProperty searches the sources in order for the given property, or for its descendants too
+// if descendants is true.
+func searchProperty(propSources *PropertySources, name string, descendants bool) (PropertySource, string, bool) {
+	parsed := ParsePropertyName(name)
+	sources, indexes := propSources.nameIndexes()
+
+	for i, propSource := range sources {
+		if _, ok := propSource.Value(name); ok {
+			return propSource, name, true
+		}
+
+		if propName, ok := indexes[i].find(parsed, descendants); ok {
+			return propSource, propName, true
+		}
+	}
+
+	return nil, "", false
+}
+
+// childProperty is a direct child of a property, such as an entry of a map property.
+type childProperty struct {
+	// key is the key of the child as it is written in the source.
+	key string
+	// name is the name of the child, with the path of its parent written as in the source.
+	name string
+}
+
+// childProperties returns the direct children of the given property, such as the entries of a map
+// property. The path of the property is matched in a relaxed way, but the keys of the children are
+// taken as they are written in the sources, so Foo and foo are different keys. A key is only returned
+// once, from the first source holding it. Indices are not returned.
+func childProperties(propSources *PropertySources, name string) []childProperty {
+	parsed := ParsePropertyName(name)
+	children := make([]childProperty, 0)
+	seen := make(map[string]struct{})
+
+	_, indexes := propSources.nameIndexes()
+	for _, index := range indexes {
+		for _, other := range index.parsed {
+			consumed, ok := parsed.matchPrefix(other)
+			if !ok || consumed >= len(other.elements) || other.elements[consumed].index {
+				continue
+			}
+
+			key := strings.TrimSpace(other.elements[consumed].raw)
+			if _, exists := seen[key]; exists {
+				continue
+			}
+
+			seen[key] = struct{}{}
+
+			childName := name + "." + key
+			if !other.envForm {
+				childName = other.prefix(consumed + 1)
+			}
+
+			children = append(children, childProperty{key: key, name: childName})
+		}
+	}
+
+	return children
+}
+
+// nameIndex holds the property names of a source in sorted order along with their parsed forms,
+// so that the names are not sorted and parsed again on each lookup.
+type nameIndex struct {
+	names  []string
+	parsed []PropertyName
+	// canonical maps the canonical forms of the names, except the names in the environment variable
+	// form, to the first name having that form.
+	canonical  map[string]string
+	hasEnvForm bool
+}
+
+// newNameIndex creates the index of the property names of the given source.
+func newNameIndex(propSource PropertySource) *nameIndex {
+	names := propSource.PropertyNames()
+	slices.Sort(names)
+
+	index := &nameIndex{
+		names:     names,
+		parsed:    make([]PropertyName, len(names)),
+		canonical: make(map[string]string, len(names)),
+	}
+
+	for i, name := range names {
+		parsed := ParsePropertyName(name)
+		index.parsed[i] = parsed
+
+		if parsed.envForm {
+			index.hasEnvForm = true
+			continue
+		}
+
+		if _, exists := index.canonical[parsed.String()]; !exists {
+			index.canonical[parsed.String()] = name
+		}
+	}
+
+	return index
+}
+
+// find returns the first name in the index that matches the given name in a relaxed way, or the first
+// name that is a descendant of it if descendants is true.
+func (i *nameIndex) find(name PropertyName, descendants bool) (string, bool) {
+	if !name.envForm {
+		if propName, ok := i.canonical[name.String()]; ok {
+			return propName, true
+		}
+
+		if !descendants && !i.hasEnvForm {
+			return "", false
+		}
+	}
+
+	for j, other := range i.parsed {
+		if name.Matches(other) || (descendants && name.IsAncestorOf(other)) {
+			return i.names[j], true
+		}
+	}
+
+	return "", false
+}
+
+// canonicalElement returns the canonical form of a name element.
+func canonicalElement(element string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' {
+			return -1
+		}
+
+		return unicode.ToLower(r)
+	}, element)
+}
+
+// isEnvFormName reports whether the name is in the environment variable form such as SERVER_PORT.
+func isEnvFormName(name string) bool {
+	return strings.Contains(name, "_") && !strings.ContainsAny(name, ".[") && strings.ToUpper(name) == name
+}
+
+// isNumeric reports whether the string consists of digits.
+func isNumeric(s string) bool {
+	return s != "" && strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' }) == -1
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePropertyName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		propName string
+		wantName string
+	}{
+		{name: "kebab case", propName: "server.max-connections", wantName: "server.maxconnections"},
+		{name: "camel case", propName: "server.maxConnections", wantName: "server.maxconnections"},
+		{name: "snake case", propName: "server.max_connections", wantName: "server.maxconnections"},
+		{name: "upper case", propName: "SERVER.MAXCONNECTIONS", wantName: "server.maxconnections"},
+		{name: "indices", propName: "server.hosts[0].ports[1]", wantName: "server.hosts[0].ports[1]"},
+		{name: "env form", propName: "SERVER_HOSTS_0_NAME", wantName: "server.hosts[0].name"},
+		{name: "single word", propName: "SERVER", wantName: "server"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+
+			// when
+			propName := ParsePropertyName(tc.propName)
+
+			// then
+			assert.Equal(t, tc.wantName, propName.String())
+		})
+	}
+}
+
+func TestPropertyName_Matches(t *testing.T) {
+	testCases := []struct {
+		name      string
+		propName  string
+		otherName string
+		wantMatch bool
+	}{
+		{name: "same name", propName: "server.port", otherName: "server.port", wantMatch: true},
+		{name: "kebab and camel case", propName: "server.max-connections", otherName: "server.maxConnections", wantMatch: true},
+		{name: "kebab and snake case", propName: "server.max-connections", otherName: "server.max_connections", wantMatch: true},
+		{name: "env form without separator", propName: "server.max-connections", otherName: "SERVER_MAXCONNECTIONS", wantMatch: true},
+		{name: "env form with separator", propName: "server.maxConnections", otherName: "SERVER_MAX_CONNECTIONS", wantMatch: true},
+		{name: "env form first", propName: "SERVER_MAX_CONNECTIONS", otherName: "server.max-connections", wantMatch: true},
+		{name: "env form with index", propName: "server.hosts[0].name", otherName: "SERVER_HOSTS_0_NAME", wantMatch: true},
+		{name: "both env form", propName: "SERVER_PORT", otherName: "SERVER_PORT", wantMatch: true},
+		{name: "different index", propName: "server.hosts[0].name", otherName: "SERVER_HOSTS_1_NAME", wantMatch: false},
+		{name: "index and key", propName: "server.hosts[0]", otherName: "server.hosts.0", wantMatch: false},
+		{name: "shorter name", propName: "server", otherName: "server.port", wantMatch: false},
+		{name: "longer name", propName: "server.port", otherName: "server", wantMatch: false},
+		{name: "shorter env form", propName: "server.port", otherName: "SERVER_PORTS_0", wantMatch: false},
+		{name: "different name", propName: "server.port", otherName: "server.host", wantMatch: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			propName := ParsePropertyName(tc.propName)
+			otherName := ParsePropertyName(tc.otherName)
+
+			// when
+			match := propName.Matches(otherName)
+
+			// then
+			assert.Equal(t, tc.wantMatch, match)
+		})
+	}
+}
+
+func TestPropertyName_IsAncestorOf(t *testing.T) {
+	testCases := []struct {
+		name         string
+		propName     string
+		otherName    string
+		wantAncestor bool
+	}{
+		{name: "parent", propName: "server", otherName: "server.port", wantAncestor: true},
+		{name: "grandparent", propName: "server", otherName: "server.hosts[0].name", wantAncestor: true},
+		{name: "list", propName: "server.hosts", otherName: "server.hosts[0]", wantAncestor: true},
+		{name: "relaxed name", propName: "server.hostNames", otherName: "server.host-names[0]", wantAncestor: true},
+		{name: "env form descendant", propName: "server.hosts[0]", otherName: "SERVER_HOSTS_0_NAME", wantAncestor: true},
+		{name: "env form ancestor", propName: "SERVER_HOSTS", otherName: "server.hosts[0].name", wantAncestor: true},
+		{name: "same name", propName: "server.port", otherName: "server.port", wantAncestor: false},
+		{name: "descendant", propName: "server.port", otherName: "server", wantAncestor: false},
+		{name: "sibling", propName: "server.hosts[0]", otherName: "server.hosts[1].name", wantAncestor: false},
+		{name: "common prefix", propName: "server", otherName: "servers.port", wantAncestor: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			propName := ParsePropertyName(tc.propName)
+			otherName := ParsePropertyName(tc.otherName)
+
+			// when
+			ancestor := propName.IsAncestorOf(otherName)
+
+			// then
+			assert.Equal(t, tc.wantAncestor, ancestor)
+		})
+	}
+}
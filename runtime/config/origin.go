@@ -0,0 +1,98 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PropertyOrigin describes where a property is defined.
+type PropertyOrigin struct {
+	// Resource is the location of the resource defining the property, such as a config file.
+	Resource string
+	// Line is the 1-based line of the property in the resource, or 0 if it is not known.
+	Line int
+	// Column is the 1-based column of the property in the resource, or 0 if it is not known.
+	Column int
+}
+
+// String returns the origin in the form of resource:line:column. The line and the column
+// are omitted if they are not known.
+func (o PropertyOrigin) String() string {
+	var sb strings.Builder
+	sb.WriteString(o.Resource)
+
+	if o.Line > 0 {
+		sb.WriteString(":")
+		sb.WriteString(strconv.Itoa(o.Line))
+
+		if o.Column > 0 {
+			sb.WriteString(":")
+			sb.WriteString(strconv.Itoa(o.Column))
+		}
+	}
+
+	return sb.String()
+}
+
+// OriginLookup can be implemented by property sources that know the origin of each of their properties.
+type OriginLookup interface {
+	// PropertyOrigin returns the origin of the given property name.
+	// If the property does not exist, it returns false.
+	PropertyOrigin(propertyName string) (PropertyOrigin, bool)
+}
+
+// OriginOf returns the origin of the given property name in the given source. If the source does not
+// implement OriginLookup, or does not know the origin of the property, the origin of the source is returned.
+func OriginOf(propSource PropertySource, propertyName string) string {
+	if lookup, ok := propSource.(OriginLookup); ok {
+		if origin, exists := lookup.PropertyOrigin(propertyName); exists {
+			return origin.String()
+		}
+	}
+
+	return propSource.Origin()
+}
+
+// OriginTrackedMapPropertySource is a MapPropertySource that knows the origin of each of its properties.
+type OriginTrackedMapPropertySource struct {
+	*MapPropertySource
+	origins map[string]PropertyOrigin
+}
+
+// NewOriginTrackedMapPropertySource function creates a new OriginTrackedMapPropertySource with the given name,
+// key-value pair map and origins of the flattened property names.
+func NewOriginTrackedMapPropertySource(name string, values map[string]any, origins map[string]PropertyOrigin) *OriginTrackedMapPropertySource {
+	if origins == nil {
+		panic("nil origins")
+	}
+
+	return &OriginTrackedMapPropertySource{
+		MapPropertySource: NewMapPropertySource(name, values),
+		origins:           origins,
+	}
+}
+
+// PropertyOrigin returns the origin of the given property name.
+// If the property does not exist, it returns false.
+func (s *OriginTrackedMapPropertySource) PropertyOrigin(name string) (PropertyOrigin, bool) {
+	if _, exists := s.Value(name); !exists {
+		return PropertyOrigin{}, false
+	}
+
+	origin, ok := s.origins[name]
+	return origin, ok
+}
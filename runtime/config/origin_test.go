@@ -0,0 +1,92 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPropertyOrigin_String(t *testing.T) {
+	testCases := []struct {
+		name       string
+		origin     PropertyOrigin
+		wantString string
+	}{
+		{
+			name:       "resource",
+			origin:     PropertyOrigin{Resource: "environment variable 'SERVER_PORT'"},
+			wantString: "environment variable 'SERVER_PORT'",
+		},
+		{
+			name:       "resource and line",
+			origin:     PropertyOrigin{Resource: "resources/procyon.yaml", Line: 3},
+			wantString: "resources/procyon.yaml:3",
+		},
+		{
+			name:       "resource, line and column",
+			origin:     PropertyOrigin{Resource: "resources/procyon.yaml", Line: 3, Column: 5},
+			wantString: "resources/procyon.yaml:3:5",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+
+			// when
+			result := tc.origin.String()
+
+			// then
+			assert.Equal(t, tc.wantString, result)
+		})
+	}
+}
+
+func TestNewOriginTrackedMapPropertySource(t *testing.T) {
+	// given
+
+	// when
+	// then
+	assert.PanicsWithValue(t, "nil origins", func() {
+		NewOriginTrackedMapPropertySource("anyName", map[string]any{}, nil)
+	})
+}
+
+func TestOriginOf(t *testing.T) {
+	// given
+	trackedSource := NewOriginTrackedMapPropertySource("anyTrackedName", map[string]any{
+		"app.name": "demo",
+		"app.port": 8080,
+	}, map[string]PropertyOrigin{
+		"app.name": {Resource: "resources/procyon.yaml", Line: 2, Column: 3},
+	})
+	mapSource := NewMapPropertySource("anyMapName", map[string]any{"app.name": "demo"})
+
+	// when
+	trackedOrigin := OriginOf(trackedSource, "app.name")
+	untrackedOrigin := OriginOf(trackedSource, "app.port")
+	mapOrigin := OriginOf(mapSource, "app.name")
+
+	// then
+	assert.Equal(t, "resources/procyon.yaml:2:3", trackedOrigin)
+	assert.Equal(t, "anyTrackedName", untrackedOrigin)
+	assert.Equal(t, "anyMapName", mapOrigin)
+
+	_, exists := trackedSource.PropertyOrigin("app.owner")
+	require.False(t, exists)
+}
@@ -0,0 +1,224 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"fmt"
+	stdio "io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"codnect.io/procyon/io"
+)
+
+// PropertiesPropertySourceLoader struct is an implementation of the PropertySourceLoader interface for
+// Java-style .properties contents.
+type PropertiesPropertySourceLoader struct {
+}
+
+// NewPropertiesPropertySourceLoader function creates a new PropertiesPropertySourceLoader.
+func NewPropertiesPropertySourceLoader() *PropertiesPropertySourceLoader {
+	return &PropertiesPropertySourceLoader{}
+}
+
+// Extensions method returns the file extensions supported by the PropertiesPropertySourceLoader.
+func (l *PropertiesPropertySourceLoader) Extensions() []string {
+	return []string{"properties"}
+}
+
+// Load method loads a config source from a resource. Each property is given by a key and a value separated
+// by '=', ':' or whitespace. Lines starting with '#' or '!' are comments, and a line ending with a backslash
+// is continued on the next line. Keys and values support the \t, \n, \r, \f and \uXXXX escapes, and list
+// elements are given by indexed keys such as hosts[0].
+func (l *PropertiesPropertySourceLoader) Load(ctx context.Context, name string, resource io.Resource) (PropertySource, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("nil context")
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("empty source name")
+	}
+
+	if resource == nil {
+		return nil, fmt.Errorf("nil resource")
+	}
+
+	reader, err := resource.Reader()
+	if err != nil {
+		return nil, fmt.Errorf("load properties property source %q: %w", name, err)
+	}
+	defer reader.Close()
+
+	var data []byte
+	data, err = stdio.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("load properties property source %q: %w", name, err)
+	}
+
+	loaded, origins, err := parseProperties(string(data), resource.Location())
+	if err != nil {
+		return nil, fmt.Errorf("load properties property source %q: %w", name, err)
+	}
+
+	return NewOriginTrackedMapPropertySource(name, loaded, origins), nil
+}
+
+// parseProperties parses the given .properties contents into a map of keys and values,
+// and a map of their origins in the given resource.
+func parseProperties(data string, resource string) (map[string]any, map[string]PropertyOrigin, error) {
+	properties := make(map[string]any)
+	origins := make(map[string]PropertyOrigin)
+	lines := strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n")
+
+	for index := 0; index < len(lines); index++ {
+		lineNumber := index + 1
+		line := strings.TrimLeft(lines[index], " \t\f")
+		column := len(lines[index]) - len(line) + 1
+
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+
+		// a line ending with an odd number of backslashes is continued on the next line
+		for endsWithContinuation(line) && index+1 < len(lines) {
+			index++
+			line = line[:len(line)-1] + strings.TrimLeft(lines[index], " \t\f")
+		}
+
+		if endsWithContinuation(line) {
+			line = line[:len(line)-1]
+		}
+
+		rawKey, rawValue := splitProperty(line)
+
+		key, err := unescapeProperty(rawKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		value, err := unescapeProperty(rawValue)
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		properties[key] = value
+		origins[key] = PropertyOrigin{Resource: resource, Line: lineNumber, Column: column}
+	}
+
+	return properties, origins, nil
+}
+
+// endsWithContinuation reports whether the given line ends with an unescaped backslash.
+func endsWithContinuation(line string) bool {
+	count := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		count++
+	}
+
+	return count%2 == 1
+}
+
+// splitProperty splits the given logical line into its raw key and raw value. The key ends at the first
+// unescaped '=', ':' or whitespace, which may be surrounded by whitespace.
+func splitProperty(line string) (string, string) {
+	keyEnd := len(line)
+	escaped := false
+
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+
+		if escaped {
+			escaped = false
+			continue
+		}
+
+		if c == '\\' {
+			escaped = true
+			continue
+		}
+
+		if c == '=' || c == ':' || c == ' ' || c == '\t' || c == '\f' {
+			keyEnd = i
+			break
+		}
+	}
+
+	rest := strings.TrimLeft(line[keyEnd:], " \t\f")
+	if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+		rest = strings.TrimLeft(rest[1:], " \t\f")
+	}
+
+	return line[:keyEnd], rest
+}
+
+// unescapeProperty replaces the escape sequences of the given key or value.
+func unescapeProperty(s string) (string, error) {
+	if !strings.Contains(s, "\\") {
+		return s, nil
+	}
+
+	var sb strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i+1 == len(s) {
+			sb.WriteByte(c)
+			continue
+		}
+
+		i++
+		switch s[i] {
+		case 't':
+			sb.WriteByte('\t')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'u':
+			if i+5 > len(s) {
+				return "", fmt.Errorf("malformed \\uXXXX escape '%s'", s[i-1:])
+			}
+
+			code, err := strconv.ParseUint(s[i+1:i+5], 16, 16)
+			if err != nil {
+				return "", fmt.Errorf("malformed \\uXXXX escape '%s'", s[i-1:i+5])
+			}
+
+			r := rune(code)
+			i += 4
+
+			// characters outside the basic multilingual plane are given by a surrogate pair
+			if utf16.IsSurrogate(r) && i+6 < len(s) && s[i+1] == '\\' && s[i+2] == 'u' {
+				if low, lowErr := strconv.ParseUint(s[i+3:i+7], 16, 16); lowErr == nil {
+					if pair := utf16.DecodeRune(r, rune(low)); pair != unicode.ReplacementChar {
+						r = pair
+						i += 6
+					}
+				}
+			}
+
+			sb.WriteRune(r)
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+
+	return sb.String(), nil
+}
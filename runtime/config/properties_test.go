@@ -0,0 +1,225 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"codnect.io/procyon/io"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPropertiesPropertySourceLoader_Extensions(t *testing.T) {
+	// given
+	loader := NewPropertiesPropertySourceLoader()
+
+	// when
+	extensions := loader.Extensions()
+
+	// then
+	assert.Equal(t, []string{"properties"}, extensions)
+}
+
+func TestPropertiesPropertySourceLoader_Load(t *testing.T) {
+	testCases := []struct {
+		name           string
+		ctx            context.Context
+		sourceName     string
+		resource       io.Resource
+		wantErr        error
+		wantProperties map[string]any
+	}{
+		{
+			name:       "nil context",
+			ctx:        nil,
+			sourceName: "anySourceName",
+			wantErr:    errors.New("nil context"),
+		},
+		{
+			name:       "empty source name",
+			ctx:        context.Background(),
+			sourceName: "",
+			resource:   io.NewFileResource("resources/procyon.properties"),
+			wantErr:    errors.New("empty source name"),
+		},
+		{
+			name:       "nil resource",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			wantErr:    errors.New("nil resource"),
+		},
+		{
+			name:       "reader error",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource:   &AnyResource{err: errors.New("reader error")},
+			wantErr:    errors.New("load properties property source \"anySourceName\": reader error"),
+		},
+		{
+			name:       "malformed unicode escape",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "app.name=demo\napp.symbol=\\u00g9"},
+			},
+			wantErr: errors.New("load properties property source \"anySourceName\": line 2: malformed \\uXXXX escape '\\u00g9'"),
+		},
+		{
+			name:       "truncated unicode escape",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "app.symbol=\\u00"},
+			},
+			wantErr: errors.New("load properties property source \"anySourceName\": line 1: malformed \\uXXXX escape '\\u00'"),
+		},
+		{
+			name:       "separators",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "a=1\nb: 2\nc 3\n  d = 4 \ne\nf=\ng:=5"},
+			},
+			wantProperties: map[string]any{
+				"a": "1",
+				"b": "2",
+				"c": "3",
+				"d": "4 ",
+				"e": "",
+				"f": "",
+				"g": "=5",
+			},
+		},
+		{
+			name:       "comments and blank lines",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "# comment\n! another comment\n\n   \nserver.port=8080\r\n"},
+			},
+			wantProperties: map[string]any{
+				"server.port": "8080",
+			},
+		},
+		{
+			name:       "escapes",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "key\\=with\\:separators\\ =value\nescaped=tab\\tnew\\nline\\\\end\\#\nunicode=caf\\u00e9 \\uD83D\\uDE00"},
+			},
+			wantProperties: map[string]any{
+				"key=with:separators ": "value",
+				"escaped":              "tab\tnew\nline\\end#",
+				"unicode":              "café 😀",
+			},
+		},
+		{
+			name:       "line continuations",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "fruits=apple, \\\n    banana, \\\n    cherry\nbackslash=ends\\\\\nnext=value\nlast=dangling\\"},
+			},
+			wantProperties: map[string]any{
+				"fruits":    "apple, banana, cherry",
+				"backslash": "ends\\",
+				"next":      "value",
+				"last":      "dangling",
+			},
+		},
+		{
+			name:       "list keys",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "server.hosts[0].name=a\nserver.hosts[1].name=b\nserver.hosts[0].name=c"},
+			},
+			wantProperties: map[string]any{
+				"server.hosts[0].name": "c",
+				"server.hosts[1].name": "b",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			loader := NewPropertiesPropertySourceLoader()
+
+			// when
+			source, err := loader.Load(tc.ctx, tc.sourceName, tc.resource)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, source)
+			assert.Equal(t, tc.sourceName, source.Name())
+			assert.ElementsMatch(t, mapKeys(tc.wantProperties), source.PropertyNames())
+
+			for wantKey, wantValue := range tc.wantProperties {
+				value, ok := source.Value(wantKey)
+				assert.True(t, ok, wantKey)
+				assert.Equal(t, wantValue, value, wantKey)
+			}
+		})
+	}
+}
+
+func TestPropertiesPropertySourceLoader_LoadOrigins(t *testing.T) {
+	// given
+	loader := NewPropertiesPropertySourceLoader()
+	resource := &AnyResource{
+		location: "resources/procyon.properties",
+		reader:   &FakeFile{contents: "# comment\napp.name=demo\n  app.description=a \\\n    long text\napp.port 8080"},
+	}
+
+	// when
+	source, err := loader.Load(context.Background(), "anySourceName", resource)
+
+	// then
+	require.NoError(t, err)
+
+	lookup, ok := source.(OriginLookup)
+	require.True(t, ok)
+
+	wantOrigins := map[string]string{
+		"app.name":        "resources/procyon.properties:2:1",
+		"app.description": "resources/procyon.properties:3:3",
+		"app.port":        "resources/procyon.properties:5:1",
+	}
+
+	for name, wantOrigin := range wantOrigins {
+		origin, exists := lookup.PropertyOrigin(name)
+		require.True(t, exists, name)
+		assert.Equal(t, wantOrigin, origin.String(), name)
+	}
+}
+
+func mapKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
@@ -17,6 +17,7 @@ package config
 import (
 	"fmt"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -29,6 +30,19 @@ type Properties interface {
 	Prefix() string
 }
 
+// RefreshableProperties can be implemented by configuration property structs that are bound again
+// when their properties change on a reload. Properties that are not refreshable keep the values
+// bound at startup.
+//
+// Only the property fields are updated on a reload, the other fields keep their values. The fields are
+// updated in place, so the structs read concurrently should implement sync.Locker, for instance by
+// embedding a sync.RWMutex, whose lock is held while the fields are updated.
+type RefreshableProperties interface {
+	Properties
+	// Refreshable reports whether the properties are bound again on a reload.
+	Refreshable() bool
+}
+
 // PropertySource interface represents a source of properties.
 type PropertySource interface {
 	// Name returns the name of the source.
@@ -45,10 +59,13 @@ type PropertySource interface {
 	PropertyNames() []string
 }
 
-// PropertySources struct is a collection of property sources.
+// PropertySources struct is a collection of property sources. The property names of the sources are
+// indexed for the lookups by relaxed names, and the indexes are built again whenever the collection
+// changes. So a source whose property names change must be replaced to be indexed again.
 type PropertySources struct {
-	items []PropertySource
-	mu    sync.RWMutex
+	items   []PropertySource
+	indexes []*nameIndex
+	mu      sync.RWMutex
 }
 
 // NewPropertySources function creates a new PropertySources.
@@ -97,6 +114,7 @@ func (s *PropertySources) PushFront(propertySource PropertySource) {
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.indexes = nil
 
 	s.removeIfPresent(propertySource)
 	if len(s.items) == 0 {
@@ -116,6 +134,7 @@ func (s *PropertySources) PushBack(propertySource PropertySource) {
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.indexes = nil
 
 	s.removeIfPresent(propertySource)
 	s.items = append(s.items, propertySource)
@@ -133,6 +152,7 @@ func (s *PropertySources) Insert(index int, propertySource PropertySource) {
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.indexes = nil
 
 	s.removeIfPresent(propertySource)
 
@@ -149,6 +169,7 @@ func (s *PropertySources) Insert(index int, propertySource PropertySource) {
 func (s *PropertySources) Remove(name string) PropertySource {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.indexes = nil
 
 	source, index := s.findByName(name)
 
@@ -168,6 +189,7 @@ func (s *PropertySources) Replace(name string, propertySource PropertySource) {
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.indexes = nil
 
 	_, index := s.findByName(name)
 
@@ -176,6 +198,23 @@ func (s *PropertySources) Replace(name string, propertySource PropertySource) {
 	}
 }
 
+// ReplaceAll replaces all the sources with the given sources at once.
+func (s *PropertySources) ReplaceAll(propertySources ...PropertySource) {
+	items := make([]PropertySource, 0, len(propertySources))
+	for _, propertySource := range propertySources {
+		if propertySource == nil {
+			panic("nil property source")
+		}
+
+		items = append(items, propertySource)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.indexes = nil
+	s.items = items
+}
+
 // Len returns the number of sources.
 func (s *PropertySources) Len() int {
 	s.mu.RLock()
@@ -207,6 +246,31 @@ func (s *PropertySources) Slice() []PropertySource {
 	return sources
 }
 
+// nameIndexes returns the sources along with the indexes of their property names. The indexes are
+// built on the first call after the sources change.
+func (s *PropertySources) nameIndexes() ([]PropertySource, []*nameIndex) {
+	s.mu.RLock()
+	if s.indexes != nil {
+		defer s.mu.RUnlock()
+		return slices.Clone(s.items), s.indexes
+	}
+	s.mu.RUnlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.indexes == nil {
+		indexes := make([]*nameIndex, len(s.items))
+		for i, propertySource := range s.items {
+			indexes[i] = newNameIndex(propertySource)
+		}
+
+		s.indexes = indexes
+	}
+
+	return slices.Clone(s.items), s.indexes
+}
+
 // removeIfPresent removes a source from the sources if it exists.
 func (s *PropertySources) removeIfPresent(propertySource PropertySource) {
 	_, index := s.findByName(propertySource.Name())
@@ -430,6 +430,55 @@ func TestPropertySources_Replace(t *testing.T) {
 	}
 }
 
+func TestPropertySources_ReplaceAll(t *testing.T) {
+	anyMapSource := NewMapPropertySource("anyMapSource", make(map[string]any))
+	anotherMapSource := NewMapPropertySource("anotherMapSource", make(map[string]any))
+	otherMapSource := NewMapPropertySource("otherMapSource", make(map[string]any))
+
+	testCases := []struct {
+		name        string
+		sources     []PropertySource
+		wantPanic   error
+		wantSources []PropertySource
+	}{
+		{
+			name:      "nil property source",
+			sources:   []PropertySource{otherMapSource, nil},
+			wantPanic: errors.New("nil property source"),
+		},
+		{
+			name:        "replace all",
+			sources:     []PropertySource{otherMapSource},
+			wantSources: []PropertySource{otherMapSource},
+		},
+		{
+			name:        "no sources",
+			wantSources: []PropertySource{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			propertySources := NewPropertySources(anyMapSource, anotherMapSource)
+
+			// when
+			if tc.wantPanic != nil {
+				require.PanicsWithValue(t, tc.wantPanic.Error(), func() {
+					propertySources.ReplaceAll(tc.sources...)
+				})
+				assert.Equal(t, []PropertySource{anyMapSource, anotherMapSource}, propertySources.items)
+				return
+			}
+
+			propertySources.ReplaceAll(tc.sources...)
+
+			// then
+			assert.Equal(t, tc.wantSources, propertySources.items)
+		})
+	}
+}
+
 func TestPropertySources_Len(t *testing.T) {
 	// given
 	anyMapSource := NewMapPropertySource("anyMapSource", make(map[string]any))
@@ -0,0 +1,163 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math"
+	mathrand "math/rand/v2"
+	"strconv"
+	"strings"
+)
+
+const (
+	// RandomPropertySourceName is the name of the random value property source.
+	RandomPropertySourceName = "random"
+
+	randomPrefix = "random."
+)
+
+// RandomValuePropertySource is a property source that generates random values for the keys
+// starting with "random.". It supports random.int, random.long, random.uuid and random.value
+// (a hex string). The int and long keys optionally take a range like random.int(10,100) where
+// the lower bound is inclusive and the upper bound exclusive, or a maximum like random.int(10).
+//
+// As a new value is generated on each lookup, the properties referring to random values with placeholders
+// resolve differently every time. DefaultPropertyResolver.LookupRaw returns them unexpanded, so that they
+// are not taken for changed properties.
+type RandomValuePropertySource struct {
+}
+
+// NewRandomValuePropertySource creates a new RandomValuePropertySource.
+func NewRandomValuePropertySource() *RandomValuePropertySource {
+	return &RandomValuePropertySource{}
+}
+
+// Name returns the name of the source.
+func (s *RandomValuePropertySource) Name() string {
+	return RandomPropertySourceName
+}
+
+// Origin returns the origin of the property source.
+func (s *RandomValuePropertySource) Origin() string {
+	return RandomPropertySourceName
+}
+
+// Value returns a new random value for the given property key.
+// If the key is not a supported random key, it returns false.
+func (s *RandomValuePropertySource) Value(key string) (any, bool) {
+	name, ok := strings.CutPrefix(key, randomPrefix)
+	if !ok {
+		return nil, false
+	}
+
+	switch {
+	case name == "uuid":
+		return randomUUID(), true
+	case name == "value":
+		return randomHex(16), true
+	case name == "int":
+		return int(mathrand.Int32()), true
+	case name == "long":
+		return mathrand.Int64(), true
+	case strings.HasPrefix(name, "int("):
+		low, high, ok := randomRange(name[len("int"):], math.MaxInt32)
+		if !ok {
+			return nil, false
+		}
+
+		return int(low + mathrand.Int64N(high-low)), true
+	case strings.HasPrefix(name, "long("):
+		low, high, ok := randomRange(name[len("long"):], math.MaxInt64)
+		if !ok {
+			return nil, false
+		}
+
+		return low + mathrand.Int64N(high-low), true
+	}
+
+	return nil, false
+}
+
+// ValueOrDefault returns a new random value for the given property key.
+// If the key is not a supported random key, it returns the default value.
+func (s *RandomValuePropertySource) ValueOrDefault(key string, defaultValue any) any {
+	value, ok := s.Value(key)
+	if !ok {
+		return defaultValue
+	}
+
+	return value
+}
+
+// PropertyNames returns no names since the random keys are generated on demand.
+func (s *RandomValuePropertySource) PropertyNames() []string {
+	return []string{}
+}
+
+// randomRange parses a range in the form of (max) or (min,max) and reports
+// whether it is valid for the given upper limit.
+func randomRange(s string, limit int64) (int64, int64, bool) {
+	if !strings.HasPrefix(s, "(") || !strings.HasSuffix(s, ")") {
+		return 0, 0, false
+	}
+
+	bounds := strings.Split(s[1:len(s)-1], ",")
+	if len(bounds) > 2 {
+		return 0, 0, false
+	}
+
+	values := make([]int64, 0, 2)
+	for _, bound := range bounds {
+		value, err := strconv.ParseInt(strings.TrimSpace(bound), 10, 64)
+		if err != nil || value < -limit-1 || value > limit {
+			return 0, 0, false
+		}
+
+		values = append(values, value)
+	}
+
+	low, high := int64(0), values[0]
+	if len(values) == 2 {
+		low, high = values[0], values[1]
+	}
+
+	// the difference of the bounds must not overflow
+	if low >= high || high-low < 0 {
+		return 0, 0, false
+	}
+
+	return low, high, true
+}
+
+// randomUUID returns a random version 4 UUID.
+func randomUUID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// randomHex returns a hex string of the given number of random bytes.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
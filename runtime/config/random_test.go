@@ -0,0 +1,161 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandomValuePropertySource_Value(t *testing.T) {
+	testCases := []struct {
+		name      string
+		key       string
+		wantOk    bool
+		wantCheck func(t *testing.T, value any)
+	}{
+		{
+			name:   "non-random key",
+			key:    "anyKey",
+			wantOk: false,
+		},
+		{
+			name:   "unknown random key",
+			key:    "random.anyKey",
+			wantOk: false,
+		},
+		{
+			name:   "int",
+			key:    "random.int",
+			wantOk: true,
+			wantCheck: func(t *testing.T, value any) {
+				assert.IsType(t, 0, value)
+			},
+		},
+		{
+			name:   "int with range",
+			key:    "random.int(10,100)",
+			wantOk: true,
+			wantCheck: func(t *testing.T, value any) {
+				require.IsType(t, 0, value)
+				assert.GreaterOrEqual(t, value.(int), 10)
+				assert.Less(t, value.(int), 100)
+			},
+		},
+		{
+			name:   "int with max",
+			key:    "random.int(5)",
+			wantOk: true,
+			wantCheck: func(t *testing.T, value any) {
+				require.IsType(t, 0, value)
+				assert.GreaterOrEqual(t, value.(int), 0)
+				assert.Less(t, value.(int), 5)
+			},
+		},
+		{
+			name:   "int with invalid range",
+			key:    "random.int(100,10)",
+			wantOk: false,
+		},
+		{
+			name:   "int with malformed range",
+			key:    "random.int(a,b)",
+			wantOk: false,
+		},
+		{
+			name:   "int out of range",
+			key:    "random.int(10000000000)",
+			wantOk: false,
+		},
+		{
+			name:   "long",
+			key:    "random.long",
+			wantOk: true,
+			wantCheck: func(t *testing.T, value any) {
+				assert.IsType(t, int64(0), value)
+			},
+		},
+		{
+			name:   "long with range",
+			key:    "random.long(10000000000,10000000002)",
+			wantOk: true,
+			wantCheck: func(t *testing.T, value any) {
+				require.IsType(t, int64(0), value)
+				assert.GreaterOrEqual(t, value.(int64), int64(10000000000))
+				assert.Less(t, value.(int64), int64(10000000002))
+			},
+		},
+		{
+			name:   "uuid",
+			key:    "random.uuid",
+			wantOk: true,
+			wantCheck: func(t *testing.T, value any) {
+				require.IsType(t, "", value)
+				assert.Regexp(t, regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`), value)
+			},
+		},
+		{
+			name:   "value",
+			key:    "random.value",
+			wantOk: true,
+			wantCheck: func(t *testing.T, value any) {
+				require.IsType(t, "", value)
+				assert.Regexp(t, regexp.MustCompile(`^[0-9a-f]{32}$`), value)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			propSource := NewRandomValuePropertySource()
+
+			// when
+			value, ok := propSource.Value(tc.key)
+
+			// then
+			require.Equal(t, tc.wantOk, ok)
+			if tc.wantCheck != nil {
+				tc.wantCheck(t, value)
+			}
+		})
+	}
+}
+
+func TestRandomValuePropertySource_Expand(t *testing.T) {
+	// given
+	propSources := NewPropertySources(
+		NewMapPropertySource("anyMapName", map[string]any{
+			"app.instance-id": "${random.uuid}",
+		}),
+		NewRandomValuePropertySource(),
+	)
+	resolver := NewDefaultPropertyResolver(propSources)
+
+	// when
+	result, err := resolver.ExpandStrict("${random.uuid}/${random.uuid}/${random.int(10,100)}")
+	instanceId, ok := resolver.Lookup("app.instance-id")
+
+	// then
+	require.NoError(t, err)
+	assert.Regexp(t, regexp.MustCompile(`^([0-9a-f-]{36})/([0-9a-f-]{36})/\d{2}$`), result)
+	assert.Equal(t, result[:36], result[37:73])
+
+	require.True(t, ok)
+	assert.Regexp(t, regexp.MustCompile(`^[0-9a-f-]{36}$`), instanceId)
+}
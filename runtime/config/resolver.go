@@ -16,6 +16,8 @@ package config
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 )
 
 // PropertyResolver is an interface for resolving properties and expanding placeholders.
@@ -48,23 +50,26 @@ func NewDefaultPropertyResolver(propSources *PropertySources) *DefaultPropertyRe
 }
 
 // Lookup returns the value of the given property name from the sources.
+// String values are returned with their placeholders expanded.
 func (r *DefaultPropertyResolver) Lookup(key string) (any, bool) {
-	for _, cfgSource := range r.propSources.Slice() {
-		if value, ok := cfgSource.Value(key); ok {
-			return value, true
-		}
+	value, ok := r.LookupRaw(key)
+	if !ok {
+		return nil, false
+	}
+
+	if str, isStr := value.(string); isStr {
+		expanded, _ := r.expand(str, newExpansion(true), []string{key})
+		return expanded, true
 	}
 
-	return nil, false
+	return value, true
 }
 
 // LookupOrDefault returns the value of the given property name from the sources.
 // If the property does not exist, it returns the default value.
 func (r *DefaultPropertyResolver) LookupOrDefault(name string, defaultValue any) any {
-	for _, source := range r.propSources.Slice() {
-		if value, ok := source.Value(name); ok {
-			return value
-		}
+	if value, ok := r.Lookup(name); ok {
+		return value
 	}
 
 	return defaultValue
@@ -73,14 +78,14 @@ func (r *DefaultPropertyResolver) LookupOrDefault(name string, defaultValue any)
 // Expand resolves placeholders in the given text.
 // If a placeholder cannot be resolved, it continues to resolve other placeholders.
 func (r *DefaultPropertyResolver) Expand(s string) string {
-	result, _ := r.expand(s, true)
+	result, _ := r.expand(s, newExpansion(true), nil)
 	return result
 }
 
 // ExpandStrict resolves placeholders in the given text.
 // If a placeholder cannot be resolved, it returns an error.
 func (r *DefaultPropertyResolver) ExpandStrict(s string) (string, error) {
-	result, err := r.expand(s, false)
+	result, err := r.expand(s, newExpansion(false), nil)
 	if err != nil {
 		return "", fmt.Errorf("expand %q: %w", s, err)
 	}
@@ -88,71 +93,188 @@ func (r *DefaultPropertyResolver) ExpandStrict(s string) (string, error) {
 	return result, nil
 }
 
-// expand resolves placeholders in the given text.
+// LookupRaw returns the value of the given property name from the sources without expanding it.
+// The property names are matched in a relaxed way, see PropertyName.
+//
+// Unlike the expanded values, the raw values do not change from one lookup to another, as placeholders
+// like ${random.uuid} are kept as they are. They can be compared to detect the changes of the properties.
+func (r *DefaultPropertyResolver) LookupRaw(key string) (any, bool) {
+	propSource, propName, ok := findProperty(r.propSources, key)
+	if !ok {
+		return nil, false
+	}
+
+	return propSource.Value(propName)
+}
+
+// expansion holds the state of a single expansion. The values looked up during the expansion are
+// kept so that a property yields the same value every time it is referenced, even if its source
+// generates a new value on each lookup like the random value property source does.
+type expansion struct {
+	continueOnError bool
+	values          map[string]any
+}
+
+// newExpansion creates a new expansion.
 // If continueOnError is true, it continues to resolve placeholders even if a placeholder cannot be resolved.
-func (r *DefaultPropertyResolver) expand(s string, continueOnError bool) (string, error) {
-	var buf []byte
-
-	i := 0
-	for j := 0; j < len(s); j++ {
-		if s[j] == '$' && j+1 < len(s) {
-			if buf == nil {
-				buf = make([]byte, 0, 2*len(s))
-			}
+func newExpansion(continueOnError bool) *expansion {
+	return &expansion{
+		continueOnError: continueOnError,
+		values:          make(map[string]any),
+	}
+}
+
+// lookup returns the value of the given property name, looking it up only once per expansion.
+func (e *expansion) lookup(r *DefaultPropertyResolver, name string) (any, bool) {
+	if value, ok := e.values[name]; ok {
+		return value, true
+	}
+
+	value, ok := r.LookupRaw(name)
+	if ok {
+		e.values[name] = value
+	}
+
+	return value, ok
+}
 
-			buf = append(buf, s[i:j]...)
-			name, w := r.extractPlaceholder(s[j+1:])
+// expand resolves placeholders in the given text.
+// The chain holds the property names being resolved and is used to detect circular references.
+func (r *DefaultPropertyResolver) expand(s string, exp *expansion, chain []string) (string, error) {
+	if !strings.Contains(s, "${") {
+		return s, nil
+	}
 
-			if name == "" && w > 0 {
-				if !continueOnError {
-					return "", fmt.Errorf("invalid placeholder at index %d", j)
-				}
+	var sb strings.Builder
+	sb.Grow(len(s))
 
-				buf = append(buf, s[j:j+w+1]...)
-			} else if name == "" {
-				buf = append(buf, s[j])
-			} else {
-				value, ok := r.Lookup(name)
+	for i := 0; i < len(s); {
+		if s[i] == '\\' && strings.HasPrefix(s[i+1:], "${") {
+			sb.WriteString("${")
+			i += 3
+			continue
+		}
 
-				if !ok {
-					if !continueOnError {
-						return "", fmt.Errorf("unresolved placeholder ${%s}", name)
-					}
+		if s[i] != '$' || !strings.HasPrefix(s[i:], "${") {
+			sb.WriteByte(s[i])
+			i++
+			continue
+		}
 
-					buf = append(buf, s[j:j+w+1]...)
-				} else {
-					buf = append(buf, fmt.Sprint(value)...)
-				}
+		end := placeholderEnd(s, i+2)
+		if end < 0 || end == i+2 {
+			if !exp.continueOnError {
+				return "", fmt.Errorf("invalid placeholder at index %d", i)
+			}
 
+			if end < 0 {
+				sb.WriteString(s[i:])
+				break
 			}
 
-			j += w
-			i = j + 1
+			sb.WriteString(s[i : end+1])
+			i = end + 1
+			continue
+		}
+
+		value, ok, err := r.resolvePlaceholder(s[i+2:end], exp, chain)
+		if err != nil {
+			return "", err
+		}
+
+		if ok {
+			sb.WriteString(value)
+		} else {
+			sb.WriteString(s[i : end+1])
 		}
+
+		i = end + 1
 	}
 
-	if buf == nil {
-		return s, nil
+	return sb.String(), nil
+}
+
+// resolvePlaceholder resolves the content of a placeholder in the form of name or name:default.
+// Nested placeholders in the name and the default value are expanded, and string values are
+// resolved recursively. It reports false if the placeholder cannot be resolved.
+func (r *DefaultPropertyResolver) resolvePlaceholder(content string, exp *expansion, chain []string) (string, bool, error) {
+	name, defaultValue, hasDefault := splitPlaceholder(content)
+
+	name, err := r.expand(name, exp, chain)
+	if err != nil {
+		return "", false, err
+	}
+
+	if slices.Contains(chain, name) {
+		if !exp.continueOnError {
+			return "", false, &CircularPlaceholderError{Chain: append(slices.Clone(chain), name)}
+		}
+
+		return "", false, nil
+	}
+
+	value, ok := exp.lookup(r, name)
+	if !ok {
+		if hasDefault {
+			expanded, err := r.expand(defaultValue, exp, chain)
+			return expanded, err == nil, err
+		}
+
+		if !exp.continueOnError {
+			return "", false, &UnresolvedPlaceholderError{Name: name}
+		}
+
+		return "", false, nil
+	}
+
+	str, isStr := value.(string)
+	if !isStr {
+		return fmt.Sprint(value), true, nil
 	}
 
-	return string(buf) + s[i:], nil
+	expanded, err := r.expand(str, exp, append(slices.Clone(chain), name))
+	if err != nil {
+		return "", false, err
+	}
+
+	return expanded, true, nil
 }
 
-// extractPlaceholder extracts the placeholder from the given string.
-func (r *DefaultPropertyResolver) extractPlaceholder(s string) (string, int) {
-	switch {
-	case s[0] == '{':
-		for i := 1; i < len(s); i++ {
-			if s[i] == '}' {
-				if i == 1 {
-					return "", 2
-				}
-				return s[1:i], i + 1
+// placeholderEnd returns the index of the closing brace matching the placeholder
+// whose content starts at the given index, or -1 if the placeholder is unterminated.
+func placeholderEnd(s string, start int) int {
+	depth := 0
+	for i := start; i < len(s); i++ {
+		switch {
+		case s[i] == '$' && i+1 < len(s) && s[i+1] == '{':
+			depth++
+			i++
+		case s[i] == '}':
+			if depth == 0 {
+				return i
 			}
+			depth--
 		}
+	}
 
-		return "", 1
+	return -1
+}
+
+// splitPlaceholder splits the content of a placeholder into its name and default value
+// at the first colon that is not part of a nested placeholder.
+func splitPlaceholder(content string) (string, string, bool) {
+	depth := 0
+	for i := 0; i < len(content); i++ {
+		switch {
+		case content[i] == '$' && i+1 < len(content) && content[i+1] == '{':
+			depth++
+			i++
+		case content[i] == '}':
+			depth--
+		case content[i] == ':' && depth == 0:
+			return content[:i], content[i+1:], true
+		}
 	}
 
-	return "", 0
+	return content, "", false
 }
@@ -16,6 +16,7 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -85,6 +86,67 @@ func TestDefaultPropertyResolver_Lookup(t *testing.T) {
 			wantExists:  true,
 			wantValue:   "anyValue",
 		},
+		{
+			name: "string value with placeholders",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{
+					"app.host": "localhost",
+					"app.url":  "http://${app.host}:${app.port:8080}",
+				}),
+			},
+			propertyKey: "app.url",
+			wantExists:  true,
+			wantValue:   "http://localhost:8080",
+		},
+		{
+			name: "non-string value",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{"app.port": 8080}),
+			},
+			propertyKey: "app.port",
+			wantExists:  true,
+			wantValue:   8080,
+		},
+		{
+			name: "property with relaxed name",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{"server.max-connections": 10}),
+			},
+			propertyKey: "server.maxConnections",
+			wantExists:  true,
+			wantValue:   10,
+		},
+		{
+			name: "property in env form",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{"SERVER_HOSTS_0_NAME": "anyHost"}),
+			},
+			propertyKey: "server.hosts[0].name",
+			wantExists:  true,
+			wantValue:   "anyHost",
+		},
+		{
+			name: "exact name takes precedence in the same source",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{
+					"server.max-connections": 10,
+					"server.maxConnections":  20,
+				}),
+			},
+			propertyKey: "server.maxConnections",
+			wantExists:  true,
+			wantValue:   20,
+		},
+		{
+			name: "former source takes precedence over exact name",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{"SERVER_MAX_CONNECTIONS": "10"}),
+				NewMapPropertySource("anotherMapName", map[string]any{"server.max-connections": 20}),
+			},
+			propertyKey: "server.max-connections",
+			wantExists:  true,
+			wantValue:   "10",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -149,6 +211,137 @@ func TestDefaultPropertyResolver_LookupOrDefault(t *testing.T) {
 	}
 }
 
+func TestDefaultPropertyResolver_LookupRaw(t *testing.T) {
+	testCases := []struct {
+		name            string
+		propertySources []PropertySource
+		propertyKey     string
+		wantExists      bool
+		wantValue       any
+	}{
+		{
+			name: "property does not exist",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{"anyKey": "anyValue"}),
+			},
+			propertyKey: "anotherKey",
+		},
+		{
+			name: "placeholder is not expanded",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{"anyKey": "${anotherKey}", "anotherKey": "anyValue"}),
+			},
+			propertyKey: "anyKey",
+			wantExists:  true,
+			wantValue:   "${anotherKey}",
+		},
+		{
+			name: "random value placeholder is not expanded",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{"app.id": "${random.uuid}"}),
+				NewRandomValuePropertySource(),
+			},
+			propertyKey: "app.id",
+			wantExists:  true,
+			wantValue:   "${random.uuid}",
+		},
+		{
+			name: "relaxed property name",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{"APP_NAME": "${random.value}"}),
+			},
+			propertyKey: "app.name",
+			wantExists:  true,
+			wantValue:   "${random.value}",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			propertySources := NewPropertySources(tc.propertySources...)
+			resolver := NewDefaultPropertyResolver(propertySources)
+
+			// when
+			value, exists := resolver.LookupRaw(tc.propertyKey)
+
+			// then
+			assert.Equal(t, tc.wantExists, exists)
+			assert.Equal(t, tc.wantValue, value)
+		})
+	}
+}
+
+func TestDefaultPropertyResolver_LookupAfterSourcesChange(t *testing.T) {
+	testCases := []struct {
+		name       string
+		change     func(propertySources *PropertySources)
+		wantExists bool
+		wantValue  any
+	}{
+		{
+			name: "source pushed back",
+			change: func(propertySources *PropertySources) {
+				propertySources.PushBack(NewMapPropertySource("anotherMapName", map[string]any{"SERVER_PORT": 8080}))
+			},
+			wantExists: true,
+			wantValue:  8080,
+		},
+		{
+			name: "source replaced",
+			change: func(propertySources *PropertySources) {
+				propertySources.Replace("anyMapName", NewMapPropertySource("anyMapName", map[string]any{"server.Port": 9090}))
+			},
+			wantExists: true,
+			wantValue:  9090,
+		},
+		{
+			name: "source removed",
+			change: func(propertySources *PropertySources) {
+				propertySources.PushFront(NewMapPropertySource("anotherMapName", map[string]any{"server-port": 8080}))
+				propertySources.Remove("anotherMapName")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			propertySources := NewPropertySources(NewMapPropertySource("anyMapName", map[string]any{"anyKey": "anyValue"}))
+			resolver := NewDefaultPropertyResolver(propertySources)
+			_, _ = resolver.Lookup("server.port")
+
+			// when
+			tc.change(propertySources)
+			value, exists := resolver.Lookup("server.port")
+
+			// then
+			assert.Equal(t, tc.wantExists, exists)
+			assert.Equal(t, tc.wantValue, value)
+		})
+	}
+}
+
+func BenchmarkDefaultPropertyResolver_LookupRelaxedName(b *testing.B) {
+	properties := make(map[string]any)
+	for i := 0; i < 500; i++ {
+		properties[fmt.Sprintf("app.group%d.max-connections", i)] = i
+	}
+
+	resolver := NewDefaultPropertyResolver(NewPropertySources(
+		NewMapPropertySource("anyMapName", properties),
+		NewMapPropertySource("anotherMapName", map[string]any{"server.port": 8080}),
+	))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		resolver.Lookup("app.group250.maxConnections")
+		resolver.Lookup("server.port")
+	}
+}
+
 func TestDefaultPropertyResolver_Expand(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -233,6 +426,63 @@ func TestDefaultPropertyResolver_Expand(t *testing.T) {
 			text:       "Server running on ${host}:${port} with profile ${environment}",
 			wantResult: "Server running on 127.0.0.1:8090 with profile dev",
 		},
+		{
+			name: "default values",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{
+					"host":     "127.0.0.1",
+					"fallback": "8081",
+				}),
+			},
+			text:       "${host:localhost}:${port:${fallback}} ${url:http://example.com} ${empty:}",
+			wantResult: "127.0.0.1:8081 http://example.com ",
+		},
+		{
+			name: "nested placeholders",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{
+					"env":         "dev",
+					"db.dev.url":  "postgres://dev",
+					"db.prod.url": "postgres://prod",
+				}),
+			},
+			text:       "${db.${env}.url} ${db.${region}.url}",
+			wantResult: "postgres://dev ${db.${region}.url}",
+		},
+		{
+			name: "recursive placeholders",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{
+					"host": "localhost",
+					"port": 8080,
+					"addr": "${host}:${port}",
+					"url":  "http://${addr}",
+				}),
+			},
+			text:       "Server running on ${url}",
+			wantResult: "Server running on http://localhost:8080",
+		},
+		{
+			name: "circular placeholders",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{
+					"a": "${b}",
+					"b": "${a}",
+				}),
+			},
+			text:       "value ${a}",
+			wantResult: "value ${a}",
+		},
+		{
+			name: "escaped placeholders",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{
+					"host": "localhost",
+				}),
+			},
+			text:       "\\${host} is ${host} and ${missing:\\${host}}",
+			wantResult: "${host} is localhost and ${host}",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -340,6 +590,64 @@ func TestDefaultPropertyResolver_ExpandStrict(t *testing.T) {
 			wantResult: "Server running on 127.0.0.1:8090 with profile dev",
 			wantErr:    nil,
 		},
+		{
+			name: "default values",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{}),
+			},
+			text:       "${host:localhost}:${port:8080}",
+			wantResult: "localhost:8080",
+		},
+		{
+			name: "unresolved nested placeholder",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{
+					"db.dev.url": "postgres://dev",
+				}),
+			},
+			text:    "${db.${env}.url}",
+			wantErr: errors.New("expand \"${db.${env}.url}\": unresolved placeholder ${env}"),
+		},
+		{
+			name: "unresolved recursive placeholder",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{
+					"url": "http://${host}",
+				}),
+			},
+			text:    "${url}",
+			wantErr: errors.New("expand \"${url}\": unresolved placeholder ${host}"),
+		},
+		{
+			name: "circular placeholders",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{
+					"a": "${b}",
+					"b": "${c}",
+					"c": "${a}",
+				}),
+			},
+			text:    "${a}",
+			wantErr: errors.New("expand \"${a}\": circular placeholder reference a -> b -> c -> a"),
+		},
+		{
+			name: "self-referencing placeholder",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{
+					"a": "x${a}",
+				}),
+			},
+			text:    "${a}",
+			wantErr: errors.New("expand \"${a}\": circular placeholder reference a -> a"),
+		},
+		{
+			name: "escaped placeholder",
+			propertySources: []PropertySource{
+				NewMapPropertySource("anyMapName", map[string]any{}),
+			},
+			text:       "\\${host}",
+			wantResult: "${host}",
+		},
 	}
 
 	for _, tc := range testCases {
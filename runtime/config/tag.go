@@ -22,6 +22,8 @@ type PropertyTag struct {
 	Optional bool `option:"optional"`
 	// Default value of the property.
 	Default any `option:"default"`
+	// Layout used to parse time values.
+	Layout string `option:"layout"`
 }
 
 // Tag method returns the tag name.
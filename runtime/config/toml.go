@@ -0,0 +1,85 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	stdio "io"
+
+	"codnect.io/procyon/io"
+	"github.com/BurntSushi/toml"
+)
+
+// TomlPropertySourceLoader struct is an implementation of the PropertySourceLoader interface for TOML contents.
+type TomlPropertySourceLoader struct {
+}
+
+// NewTomlPropertySourceLoader function creates a new TomlPropertySourceLoader.
+func NewTomlPropertySourceLoader() *TomlPropertySourceLoader {
+	return &TomlPropertySourceLoader{}
+}
+
+// Extensions method returns the file extensions supported by the TomlPropertySourceLoader.
+func (l *TomlPropertySourceLoader) Extensions() []string {
+	return []string{"toml"}
+}
+
+// Load method loads a config source from a resource. The tables and arrays are flattened into dot-separated
+// keys with indexed list elements like the YAML contents.
+func (l *TomlPropertySourceLoader) Load(ctx context.Context, name string, resource io.Resource) (PropertySource, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("nil context")
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("empty source name")
+	}
+
+	if resource == nil {
+		return nil, fmt.Errorf("nil resource")
+	}
+
+	reader, err := resource.Reader()
+	if err != nil {
+		return nil, fmt.Errorf("load toml property source %q: %w", name, err)
+	}
+	defer reader.Close()
+
+	var data []byte
+	data, err = stdio.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("load toml property source %q: %w", name, err)
+	}
+
+	loaded := make(map[string]any)
+
+	if _, err = toml.Decode(string(data), &loaded); err != nil {
+		return nil, fmt.Errorf("load toml property source %q: %w", name, tomlError(err))
+	}
+
+	return NewMapPropertySource(name, loaded), nil
+}
+
+// tomlError replaces the toml parse errors with an error giving their line and column.
+func tomlError(err error) error {
+	var parseErr toml.ParseError
+	if !errors.As(err, &parseErr) {
+		return err
+	}
+
+	return fmt.Errorf("line %d, column %d: %s", parseErr.Position.Line, parseErr.Position.Col, parseErr.Message)
+}
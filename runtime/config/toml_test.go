@@ -0,0 +1,145 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"codnect.io/procyon/io"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTomlPropertySourceLoader_Extensions(t *testing.T) {
+	// given
+	loader := NewTomlPropertySourceLoader()
+
+	// when
+	extensions := loader.Extensions()
+
+	// then
+	assert.Equal(t, []string{"toml"}, extensions)
+}
+
+func TestTomlPropertySourceLoader_Load(t *testing.T) {
+	testCases := []struct {
+		name           string
+		ctx            context.Context
+		sourceName     string
+		resource       io.Resource
+		wantErr        error
+		wantProperties map[string]any
+	}{
+		{
+			name:       "nil context",
+			ctx:        nil,
+			sourceName: "anySourceName",
+			wantErr:    errors.New("nil context"),
+		},
+		{
+			name:       "empty source name",
+			ctx:        context.Background(),
+			sourceName: "",
+			resource:   io.NewFileResource("resources/procyon.toml"),
+			wantErr:    errors.New("empty source name"),
+		},
+		{
+			name:       "nil resource",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			wantErr:    errors.New("nil resource"),
+		},
+		{
+			name:       "reader error",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource:   &AnyResource{err: errors.New("reader error")},
+			wantErr:    errors.New("load toml property source \"anySourceName\": reader error"),
+		},
+		{
+			name:       "invalid toml",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: "[server]\nport = 8080\nname = \n"},
+			},
+			wantErr: errors.New("load toml property source \"anySourceName\": line 3, column 8: expected value but found '\\n' instead"),
+		},
+		{
+			name:       "valid toml",
+			ctx:        context.Background(),
+			sourceName: "anySourceName",
+			resource: &AnyResource{
+				reader: &FakeFile{contents: `
+title = "demo"
+
+[server]
+port = 8080
+ratio = 0.5
+enabled = true
+started = 2025-01-02T03:04:05Z
+
+[[server.hosts]]
+name = "a"
+
+[[server.hosts]]
+name = "b"
+tags = ["x", "y"]
+`},
+			},
+			wantProperties: map[string]any{
+				"title":                   "demo",
+				"server.port":             int64(8080),
+				"server.ratio":            0.5,
+				"server.enabled":          true,
+				"server.started":          time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+				"server.hosts[0].name":    "a",
+				"server.hosts[1].name":    "b",
+				"server.hosts[1].tags[0]": "x",
+				"server.hosts[1].tags[1]": "y",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			loader := NewTomlPropertySourceLoader()
+
+			// when
+			source, err := loader.Load(tc.ctx, tc.sourceName, tc.resource)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, source)
+			assert.Equal(t, tc.sourceName, source.Name())
+			assert.ElementsMatch(t, mapKeys(tc.wantProperties), source.PropertyNames())
+
+			for wantKey, wantValue := range tc.wantProperties {
+				value, ok := source.Value(wantKey)
+				assert.True(t, ok, wantKey)
+				assert.Equal(t, wantValue, value, wantKey)
+			}
+		})
+	}
+}
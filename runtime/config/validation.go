@@ -0,0 +1,346 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"codnect.io/tag"
+)
+
+// Validatable can be implemented by configuration property structs to check constraints
+// that cannot be expressed with validate tags, such as the ones involving multiple properties.
+// Validate is called after the properties are bound and their validate tags are checked.
+type Validatable interface {
+	// Validate returns an error if the bound properties are not valid. The violations of
+	// a returned ValidationError are reported as they are, any other error is reported
+	// as a violation of the properties with the struct's name.
+	Validate() error
+}
+
+// validateTagName is the name of the struct tag holding the validation rules.
+const validateTagName = "validate"
+
+// validationRule is a constraint given in a validate tag.
+type validationRule struct {
+	name  string
+	value string
+}
+
+// parseValidationRules parses the rules of a validate tag like required,min=1,max=10,oneof=a b,pattern=^x.
+// As a pattern may contain commas, the pattern rule takes the rest of the tag.
+func parseValidationRules(s string) ([]validationRule, error) {
+	rules := make([]validationRule, 0)
+
+	for s != "" {
+		var part string
+		if strings.HasPrefix(s, "pattern=") {
+			part, s = s, ""
+		} else {
+			part, s, _ = strings.Cut(s, ",")
+		}
+
+		name, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch name {
+		case "required":
+			if value != "" {
+				return nil, errors.New("rule 'required' takes no value")
+			}
+		case "min", "max", "oneof", "pattern":
+			if value == "" {
+				return nil, fmt.Errorf("rule '%s' requires a value", name)
+			}
+		default:
+			return nil, fmt.Errorf("unknown rule '%s'", name)
+		}
+
+		rules = append(rules, validationRule{name: name, value: value})
+	}
+
+	return rules, nil
+}
+
+// validate method checks the validate tags and the Validatable implementations of the bound target.
+// It returns a ValidationError listing every violation.
+func (b *DefaultPropertyBinder) validate(name string, targetVal reflect.Value) error {
+	violations := make([]PropertyViolation, 0)
+
+	if err := b.validateValue(name, targetVal, &violations); err != nil {
+		return err
+	}
+
+	if len(violations) != 0 {
+		return &ValidationError{Violations: violations}
+	}
+
+	return nil
+}
+
+// validateValue method validates the nested structs of the given value.
+func (b *DefaultPropertyBinder) validateValue(name string, val reflect.Value, violations *[]PropertyViolation) error {
+	if b.converters.CanConvert(val.Type()) {
+		return nil
+	}
+
+	switch val.Kind() {
+	case reflect.Struct:
+		return b.validateStruct(name, val, violations)
+	case reflect.Slice:
+		for i := 0; i < val.Len(); i++ {
+			if err := b.validateValue(fmt.Sprintf("%s[%d]", name, i), val.Index(i), violations); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := val.MapRange()
+		for iter.Next() {
+			// map values are not addressable, a copy is validated so that Validatable works with pointer receivers
+			elem := reflect.New(iter.Value().Type()).Elem()
+			elem.Set(iter.Value())
+
+			if err := b.validateValue(fmt.Sprintf("%s.%v", name, iter.Key()), elem, violations); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateStruct method checks the validate tags of the struct fields, then the Validatable implementation of the struct.
+func (b *DefaultPropertyBinder) validateStruct(name string, structVal reflect.Value, violations *[]PropertyViolation) error {
+	structType := structVal.Type()
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		fieldVal := structVal.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if len(field.Tag) == 0 {
+			if field.Anonymous && fieldVal.Kind() == reflect.Struct {
+				if err := b.validateStruct(name, fieldVal, violations); err != nil {
+					return err
+				}
+			}
+
+			continue
+		}
+
+		propTag := &PropertyTag{}
+		if err := tag.Parse(string(field.Tag), propTag); err != nil {
+			return fmt.Errorf("struct field %q: parse tag '%s': %w", field.Name, field.Tag, err)
+		}
+
+		propName := fmt.Sprintf("%s.%s", name, propTag.Name)
+
+		if rulesTag, ok := field.Tag.Lookup(validateTagName); ok {
+			rules, err := parseValidationRules(rulesTag)
+			if err != nil {
+				return fmt.Errorf("struct field %q: parse validate tag '%s': %w", field.Name, rulesTag, err)
+			}
+
+			origin := b.propertyOrigin(propName)
+			if origin == "" && propTag.Default != nil {
+				origin = "default value"
+			}
+
+			for _, rule := range rules {
+				// an optional property that is not set is only checked against the required rule
+				if origin == "" && rule.name != "required" {
+					continue
+				}
+
+				message, err := b.checkRule(rule, fieldVal)
+				if err != nil {
+					return fmt.Errorf("struct field %q: validate rule '%s': %w", field.Name, rule.name, err)
+				}
+
+				if message != "" {
+					*violations = append(*violations, PropertyViolation{
+						Name:    propName,
+						Value:   fieldVal.Interface(),
+						Origin:  origin,
+						Message: message,
+					})
+				}
+			}
+		}
+
+		if err := b.validateValue(propName, fieldVal, violations); err != nil {
+			return err
+		}
+	}
+
+	return b.checkValidatable(name, structVal, violations)
+}
+
+// checkValidatable method calls the Validate method of the struct if it implements the Validatable interface.
+func (b *DefaultPropertyBinder) checkValidatable(name string, structVal reflect.Value, violations *[]PropertyViolation) error {
+	var validatable Validatable
+	if structVal.CanAddr() {
+		validatable, _ = structVal.Addr().Interface().(Validatable)
+	} else {
+		validatable, _ = structVal.Interface().(Validatable)
+	}
+
+	if validatable == nil {
+		return nil
+	}
+
+	err := validatable.Validate()
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		*violations = append(*violations, validationErr.Violations...)
+		return nil
+	}
+
+	*violations = append(*violations, PropertyViolation{
+		Name:    name,
+		Message: err.Error(),
+	})
+	return nil
+}
+
+// checkRule method checks the given rule against the value. It returns a message describing
+// the violation, or an empty string if the value satisfies the rule.
+func (b *DefaultPropertyBinder) checkRule(rule validationRule, val reflect.Value) (string, error) {
+	switch rule.name {
+	case "required":
+		if val.IsZero() || (isSized(val) && val.Len() == 0) {
+			return "must be set", nil
+		}
+	case "min", "max":
+		return b.checkBound(rule, val)
+	case "oneof":
+		options := strings.Fields(rule.value)
+		if !slices.Contains(options, fmt.Sprint(val.Interface())) {
+			return fmt.Sprintf("must be one of [%s]", strings.Join(options, ", ")), nil
+		}
+	case "pattern":
+		pattern, err := regexp.Compile(rule.value)
+		if err != nil {
+			return "", err
+		}
+
+		if !pattern.MatchString(fmt.Sprint(val.Interface())) {
+			return fmt.Sprintf("must match pattern '%s'", rule.value), nil
+		}
+	}
+
+	return "", nil
+}
+
+// checkBound method checks a min or max rule. The length is compared for strings, slices and maps,
+// and the value for numbers. The bound of a type with a converter like time.Duration is converted first,
+// so that the rules like min=1s can be given.
+func (b *DefaultPropertyBinder) checkBound(rule validationRule, val reflect.Value) (string, error) {
+	var (
+		actual, bound float64
+		subject       = "be"
+	)
+
+	switch {
+	case isSized(val):
+		n, err := strconv.Atoi(rule.value)
+		if err != nil {
+			return "", err
+		}
+
+		actual, bound, subject = float64(val.Len()), float64(n), "have a length of"
+	case b.converters.CanConvert(val.Type()) && isNumber(val):
+		converted, err := b.converters.Convert(rule.value, val.Type(), ConvertOptions{})
+		if err != nil {
+			return "", err
+		}
+
+		actual, bound = numberOf(val), numberOf(reflect.ValueOf(converted))
+	case isNumber(val):
+		n, err := strconv.ParseFloat(rule.value, 64)
+		if err != nil {
+			return "", err
+		}
+
+		actual, bound = numberOf(val), n
+	default:
+		return "", fmt.Errorf("unsupported type %s", val.Type())
+	}
+
+	if rule.name == "min" && actual < bound {
+		return fmt.Sprintf("must %s at least %s", subject, rule.value), nil
+	}
+
+	if rule.name == "max" && actual > bound {
+		return fmt.Sprintf("must %s at most %s", subject, rule.value), nil
+	}
+
+	return "", nil
+}
+
+// propertyOrigin method returns the origin of the given property, see OriginOf.
+// The properties of lists and maps are looked up by their first element.
+func (b *DefaultPropertyBinder) propertyOrigin(name string) string {
+	if propSource, propName, ok := findDescendant(b.propSources, name); ok {
+		return OriginOf(propSource, propName)
+	}
+
+	return ""
+}
+
+// isSized reports whether the value has a length that the rules are checked against.
+func isSized(val reflect.Value) bool {
+	switch val.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return true
+	default:
+		return false
+	}
+}
+
+// isNumber reports whether the value is a number.
+func isNumber(val reflect.Value) bool {
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// numberOf returns the number held by the value as a float64.
+func numberOf(val reflect.Value) float64 {
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(val.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(val.Uint())
+	default:
+		return val.Float()
+	}
+}
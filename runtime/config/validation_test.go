@@ -0,0 +1,238 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ValidatedServerConfig struct {
+	Host    string                   `property:"host,optional" validate:"required"`
+	Port    int                      `property:"port,default=0" validate:"min=1,max=65535"`
+	Mode    string                   `property:"mode,default=debug" validate:"oneof=debug release"`
+	Name    string                   `property:"name,optional" validate:"pattern=^[a-z]{2,}(,[a-z]+)?$"`
+	Timeout time.Duration            `property:"timeout,default=1s" validate:"min=5s"`
+	Tags    []string                 `property:"tags,optional" validate:"max=2"`
+	Nodes   map[string]ValidatedNode `property:"nodes,optional"`
+	Labels  map[string]string        `property:"labels,optional"`
+}
+
+type ValidatedNode struct {
+	Weight int `property:"weight" validate:"max=100"`
+}
+
+type ValidatableRangeConfig struct {
+	Low  int `property:"low"`
+	High int `property:"high"`
+}
+
+func (c *ValidatableRangeConfig) Validate() error {
+	if c.Low > c.High {
+		return errors.New("low must not be greater than high")
+	}
+
+	return nil
+}
+
+type ConfigWithUnknownRule struct {
+	Port int `property:"port" validate:"positive"`
+}
+
+type ConfigWithInvalidRuleValue struct {
+	Port int `property:"port" validate:"min=one"`
+}
+
+func TestParseValidationRules(t *testing.T) {
+	testCases := []struct {
+		name      string
+		tag       string
+		wantRules []validationRule
+		wantErr   error
+	}{
+		{
+			name: "rules",
+			tag:  "required,min=1,max=65535,oneof=a b,pattern=^x,y$",
+			wantRules: []validationRule{
+				{name: "required"},
+				{name: "min", value: "1"},
+				{name: "max", value: "65535"},
+				{name: "oneof", value: "a b"},
+				{name: "pattern", value: "^x,y$"},
+			},
+		},
+		{
+			name:    "unknown rule",
+			tag:     "required,positive",
+			wantErr: errors.New("unknown rule 'positive'"),
+		},
+		{
+			name:    "rule without value",
+			tag:     "min",
+			wantErr: errors.New("rule 'min' requires a value"),
+		},
+		{
+			name:    "required with value",
+			tag:     "required=true",
+			wantErr: errors.New("rule 'required' takes no value"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+
+			// when
+			rules, err := parseValidationRules(tc.tag)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantRules, rules)
+		})
+	}
+}
+
+func TestDefaultBinder_BindWithValidation(t *testing.T) {
+	testCases := []struct {
+		name           string
+		props          map[string]any
+		targetType     reflect.Type
+		wantViolations []PropertyViolation
+		wantErr        error
+	}{
+		{
+			name: "valid properties",
+			props: map[string]any{
+				"server.host":    "localhost",
+				"server.port":    8080,
+				"server.mode":    "release",
+				"server.name":    "api,v",
+				"server.timeout": "10s",
+				"server.tags":    []any{"a", "b"},
+			},
+			targetType: reflect.TypeFor[ValidatedServerConfig](),
+		},
+		{
+			name: "invalid properties",
+			props: map[string]any{
+				"server.port":    70000,
+				"server.mode":    "test",
+				"server.name":    "X",
+				"server.tags":    []any{"a", "b", "c"},
+				"server.nodes":   map[string]any{"first": map[string]any{"weight": 150}},
+				"server.labels":  map[string]any{"env": "prod"},
+				"server.timeout": "2s",
+			},
+			targetType: reflect.TypeFor[ValidatedServerConfig](),
+			wantViolations: []PropertyViolation{
+				{Name: "server.host", Value: "", Message: "must be set"},
+				{Name: "server.port", Value: 70000, Origin: "anyMapSource", Message: "must be at most 65535"},
+				{Name: "server.mode", Value: "test", Origin: "anyMapSource", Message: "must be one of [debug, release]"},
+				{Name: "server.name", Value: "X", Origin: "anyMapSource", Message: "must match pattern '^[a-z]{2,}(,[a-z]+)?$'"},
+				{Name: "server.timeout", Value: 2 * time.Second, Origin: "anyMapSource", Message: "must be at least 5s"},
+				{Name: "server.tags", Value: []string{"a", "b", "c"}, Origin: "anyMapSource", Message: "must have a length of at most 2"},
+				{Name: "server.nodes.first.weight", Value: 150, Origin: "anyMapSource", Message: "must be at most 100"},
+			},
+		},
+		{
+			name: "invalid default value",
+			props: map[string]any{
+				"server.host":    "localhost",
+				"server.timeout": "10s",
+			},
+			targetType: reflect.TypeFor[ValidatedServerConfig](),
+			wantViolations: []PropertyViolation{
+				{Name: "server.port", Value: 0, Origin: "default value", Message: "must be at least 1"},
+			},
+		},
+		{
+			name: "validatable",
+			props: map[string]any{
+				"server.low":  10,
+				"server.high": 5,
+			},
+			targetType: reflect.TypeFor[ValidatableRangeConfig](),
+			wantViolations: []PropertyViolation{
+				{Name: "server", Message: "low must not be greater than high"},
+			},
+		},
+		{
+			name:       "unknown rule",
+			props:      map[string]any{"server.port": 8080},
+			targetType: reflect.TypeFor[ConfigWithUnknownRule](),
+			wantErr:    errors.New("bind property \"server\" to config.ConfigWithUnknownRule: struct field \"Port\": parse validate tag 'positive': unknown rule 'positive'"),
+		},
+		{
+			name:       "invalid rule value",
+			props:      map[string]any{"server.port": 8080},
+			targetType: reflect.TypeFor[ConfigWithInvalidRuleValue](),
+			wantErr:    errors.New("bind property \"server\" to config.ConfigWithInvalidRuleValue: struct field \"Port\": validate rule 'min': strconv.ParseFloat: parsing \"one\": invalid syntax"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			propSources := NewPropertySources(NewMapPropertySource("anyMapSource", tc.props))
+			binder := NewDefaultPropertyBinder(propSources)
+			target := reflect.New(tc.targetType).Interface()
+
+			// when
+			err := binder.Bind("server", target)
+
+			// then
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			if len(tc.wantViolations) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tc.wantViolations, validationErr.Violations)
+		})
+	}
+}
+
+func TestValidationError_Error(t *testing.T) {
+	// given
+	err := &ValidationError{
+		Violations: []PropertyViolation{
+			{Name: "server.port", Value: 0, Origin: "config/procyon.yaml", Message: "must be at least 1"},
+			{Name: "server", Message: "low must not be greater than high"},
+		},
+	}
+
+	// when
+	message := err.Error()
+
+	// then
+	assert.Equal(t, "invalid properties: server.port: must be at least 1, but was '0' (from config/procyon.yaml); "+
+		"server: low must not be greater than high", message)
+}
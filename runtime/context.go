@@ -28,6 +28,9 @@ type Context interface {
 	// Lifecycle interface provides start/stop lifecycle methods for the application context.
 	Lifecycle
 
+	// EventPublisher interface publishes events to the event listener components of the context.
+	EventPublisher
+
 	// Environment returns the runtime environment associated with this context.
 	Environment() Environment
 
@@ -42,6 +45,14 @@ type Context interface {
 
 	// Close closes the application context and releases all resources.
 	Close(ctx context.Context) error
+
+	// Restart closes the current container if the context is active, prepares the environment again
+	// and refreshes a fresh container. It can also be used to reopen a closed context.
+	Restart(ctx context.Context) error
+
+	// Reload loads the properties of the environment again and applies the changes without restarting
+	// the context, unless a restart is required.
+	Reload(ctx context.Context) error
 }
 
 // ContextInitializer is an interface for initializing the application context.
@@ -15,6 +15,7 @@
 package runtime
 
 import (
+	"fmt"
 	"os"
 	"strings"
 
@@ -105,6 +106,18 @@ func (s *EnvPropertySource) Value(key string) (any, bool) {
 	return nil, false
 }
 
+// PropertyOrigin method returns the origin of the environment property with the given key,
+// which is the environment variable holding its value.
+func (s *EnvPropertySource) PropertyOrigin(key string) (config.PropertyOrigin, bool) {
+	for _, name := range []string{strings.ToLower(key), strings.ToUpper(key)} {
+		if variable, exists := s.checkPropertyName(name); exists {
+			return config.PropertyOrigin{Resource: fmt.Sprintf("environment variable '%s'", variable)}, true
+		}
+	}
+
+	return config.PropertyOrigin{}, false
+}
+
 // ValueOrDefault returns the value of the given environment property key from the source.
 // If the environment property does not exist, it returns the default value.
 func (s *EnvPropertySource) ValueOrDefault(key string, defaultValue any) any {
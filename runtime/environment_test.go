@@ -45,6 +45,21 @@ func TestEnvPropertySource_Origin(t *testing.T) {
 	assert.Equal(t, "os.Environ()", origin)
 }
 
+func TestEnvPropertySource_PropertyOrigin(t *testing.T) {
+	// given
+	t.Setenv("ANY_SERVER_PORT", "8080")
+	envPropSource := NewEnvPropertySource()
+
+	// when
+	origin, exists := envPropSource.PropertyOrigin("any.server-port")
+	_, anotherExists := envPropSource.PropertyOrigin("another.server-port")
+
+	// then
+	require.True(t, exists)
+	assert.Equal(t, "environment variable 'ANY_SERVER_PORT'", origin.String())
+	assert.False(t, anotherExists)
+}
+
 func TestEnvPropertySource_Value(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -0,0 +1,123 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"slices"
+)
+
+// Event represents an event published by the application.
+type Event interface {
+	// Source returns the object on which the event occurred.
+	Source() any
+}
+
+// EventListener is implemented by components that are notified of the events published by the
+// application context. Listeners are notified in their order, see component.Ordered.
+type EventListener interface {
+	// OnEvent handles the given event. Returning an error stops the notification of the remaining
+	// listeners and is reported to the publisher.
+	OnEvent(ctx context.Context, event Event) error
+}
+
+// EventPublisher publishes events to the event listeners.
+type EventPublisher interface {
+	// PublishEvent notifies the event listeners of the given event.
+	PublishEvent(ctx context.Context, event Event) error
+}
+
+// ContextEvent is the base of the events published by the application context.
+type ContextEvent struct {
+	ctx Context
+}
+
+// Source returns the application context the event occurred on.
+func (e *ContextEvent) Source() any {
+	return e.ctx
+}
+
+// Context returns the application context the event occurred on.
+func (e *ContextEvent) Context() Context {
+	return e.ctx
+}
+
+// ContextRefreshedEvent is published after the application context has been refreshed.
+type ContextRefreshedEvent struct {
+	ContextEvent
+}
+
+// NewContextRefreshedEvent creates a new ContextRefreshedEvent for the given context.
+func NewContextRefreshedEvent(ctx Context) *ContextRefreshedEvent {
+	return &ContextRefreshedEvent{ContextEvent{ctx: requireContext(ctx)}}
+}
+
+// ContextClosedEvent is published when the application context is about to be closed, while its
+// components are still available.
+type ContextClosedEvent struct {
+	ContextEvent
+}
+
+// NewContextClosedEvent creates a new ContextClosedEvent for the given context.
+func NewContextClosedEvent(ctx Context) *ContextClosedEvent {
+	return &ContextClosedEvent{ContextEvent{ctx: requireContext(ctx)}}
+}
+
+// ContextRestartingEvent is published before the application context is restarted. A listener can
+// prevent the restart by returning an error.
+type ContextRestartingEvent struct {
+	ContextEvent
+}
+
+// NewContextRestartingEvent creates a new ContextRestartingEvent for the given context.
+func NewContextRestartingEvent(ctx Context) *ContextRestartingEvent {
+	return &ContextRestartingEvent{ContextEvent{ctx: requireContext(ctx)}}
+}
+
+// ContextRestartedEvent is published after the application context has been restarted.
+type ContextRestartedEvent struct {
+	ContextEvent
+}
+
+// NewContextRestartedEvent creates a new ContextRestartedEvent for the given context.
+func NewContextRestartedEvent(ctx Context) *ContextRestartedEvent {
+	return &ContextRestartedEvent{ContextEvent{ctx: requireContext(ctx)}}
+}
+
+// PropertiesChangedEvent is published after the properties of the application context have been
+// reloaded and the refreshable properties have been bound again.
+type PropertiesChangedEvent struct {
+	ContextEvent
+	keys []string
+}
+
+// NewPropertiesChangedEvent creates a new PropertiesChangedEvent for the given context and changed keys.
+func NewPropertiesChangedEvent(ctx Context, keys []string) *PropertiesChangedEvent {
+	return &PropertiesChangedEvent{ContextEvent{ctx: requireContext(ctx)}, slices.Clone(keys)}
+}
+
+// Keys returns the names of the properties that have been added, removed or modified.
+func (e *PropertiesChangedEvent) Keys() []string {
+	return slices.Clone(e.keys)
+}
+
+// requireContext panics if the given context is nil.
+func requireContext(ctx Context) Context {
+	if ctx == nil {
+		panic("nil context")
+	}
+
+	return ctx
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type anyContext struct {
+	Context
+}
+
+func TestContextEvents(t *testing.T) {
+	testCases := []struct {
+		name     string
+		newEvent func(ctx Context) Event
+	}{
+		{
+			name: "refreshed event",
+			newEvent: func(ctx Context) Event {
+				return NewContextRefreshedEvent(ctx)
+			},
+		},
+		{
+			name: "closed event",
+			newEvent: func(ctx Context) Event {
+				return NewContextClosedEvent(ctx)
+			},
+		},
+		{
+			name: "restarting event",
+			newEvent: func(ctx Context) Event {
+				return NewContextRestartingEvent(ctx)
+			},
+		},
+		{
+			name: "restarted event",
+			newEvent: func(ctx Context) Event {
+				return NewContextRestartedEvent(ctx)
+			},
+		},
+		{
+			name: "properties changed event",
+			newEvent: func(ctx Context) Event {
+				return NewPropertiesChangedEvent(ctx, []string{"anyKey"})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			ctx := &anyContext{}
+
+			// when
+			event := tc.newEvent(ctx)
+
+			// then
+			require.PanicsWithValue(t, "nil context", func() {
+				tc.newEvent(nil)
+			})
+
+			assert.Same(t, ctx, event.Source())
+			assert.Same(t, ctx, event.(interface{ Context() Context }).Context())
+		})
+	}
+}
+
+func TestPropertiesChangedEvent_Keys(t *testing.T) {
+	// given
+	keys := []string{"anyKey", "anotherKey"}
+	event := NewPropertiesChangedEvent(&anyContext{}, keys)
+
+	// when
+	keys[0] = "modifiedKey"
+	result := event.Keys()
+	result[1] = "modifiedKey"
+
+	// then
+	assert.Equal(t, []string{"anyKey", "anotherKey"}, event.Keys())
+}
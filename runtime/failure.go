@@ -0,0 +1,32 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+// FailureAnalysis is the result of analyzing an application failure. It describes
+// the failure and the action that should be taken to fix it.
+type FailureAnalysis struct {
+	// Description describes the failure.
+	Description string
+	// Action describes how the failure can be fixed.
+	Action string
+	// Cause is the error that caused the failure.
+	Cause error
+}
+
+// FailureAnalyzer interface allows turning known application failures into human-readable reports.
+type FailureAnalyzer interface {
+	// Analyze method analyzes the given error. It returns nil if the error is not recognized.
+	Analyze(err error) *FailureAnalysis
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrReloadVetoed is returned by a reload that has been vetoed by a ReloadAware component.
+var ErrReloadVetoed = errors.New("reload vetoed")
+
+// ReloadDecision represents the decision of a ReloadAware component about a reload of properties.
+type ReloadDecision int
+
+const (
+	// ReloadAccept accepts the changes, which are applied without restarting the context.
+	ReloadAccept ReloadDecision = iota
+	// ReloadVeto rejects the changes, which are not applied at all.
+	ReloadVeto
+	// ReloadRestart requests a restart of the context to apply the changes.
+	ReloadRestart
+)
+
+// String returns the string representation of the decision.
+func (d ReloadDecision) String() string {
+	switch d {
+	case ReloadAccept:
+		return "accept"
+	case ReloadVeto:
+		return "veto"
+	case ReloadRestart:
+		return "restart"
+	default:
+		return "unknown"
+	}
+}
+
+// ReloadAware is implemented by components that cannot apply changed properties at runtime. They are
+// consulted, in their order, before the changes of a reload are applied.
+type ReloadAware interface {
+	// BeforeReload returns the decision of the component about the given changed property keys.
+	BeforeReload(ctx context.Context, changedKeys []string) ReloadDecision
+}
@@ -19,3 +19,12 @@ type CommandLineRunner interface {
 	// Run method runs the command-line application with the given arguments.
 	Run(ctx Context, args *Args) error
 }
+
+// CommandRunner interface allows creating a runner that runs only when its command is given
+// as the first non-option argument, which makes it possible to build multi-command applications.
+type CommandRunner interface {
+	CommandLineRunner
+
+	// Command method returns the name of the command the runner handles.
+	Command() string
+}
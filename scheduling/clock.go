@@ -0,0 +1,192 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock provides the current time and timers to the task scheduler, so that the passage
+// of time can be controlled in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// NewTimer creates a new timer that fires after the given duration.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer represents a single event created by a Clock.
+type Timer interface {
+	// C returns the channel on which the time is delivered when the timer fires.
+	C() <-chan time.Time
+	// Stop prevents the timer from firing. It returns false if the timer has already fired or been stopped.
+	Stop() bool
+}
+
+// SystemClock is a Clock backed by the time package.
+type SystemClock struct {
+}
+
+// NewSystemClock creates a new SystemClock.
+func NewSystemClock() *SystemClock {
+	return &SystemClock{}
+}
+
+// Now returns the current local time.
+func (c *SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// NewTimer creates a new timer that fires after the given duration.
+func (c *SystemClock) NewTimer(d time.Duration) Timer {
+	return &systemTimer{
+		timer: time.NewTimer(d),
+	}
+}
+
+// systemTimer is a Timer backed by time.Timer.
+type systemTimer struct {
+	timer *time.Timer
+}
+
+// C returns the channel on which the time is delivered.
+func (t *systemTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+// Stop prevents the timer from firing.
+func (t *systemTimer) Stop() bool {
+	return t.timer.Stop()
+}
+
+// FakeClock is a Clock whose time only moves when it is advanced explicitly. It is intended
+// to be used in tests of scheduled tasks.
+type FakeClock struct {
+	now     time.Time
+	timers  []*fakeTimer
+	mu      sync.Mutex
+	changed *sync.Cond
+}
+
+// NewFakeClock creates a new FakeClock set to the given time.
+func NewFakeClock(now time.Time) *FakeClock {
+	clock := &FakeClock{
+		now: now,
+	}
+
+	clock.changed = sync.NewCond(&clock.mu)
+	return clock
+}
+
+// Now returns the current time of the clock.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTimer creates a new timer that fires once the clock has been advanced by the given duration.
+// A timer with a non-positive duration fires immediately.
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	timer := &fakeTimer{
+		clock:    c,
+		deadline: c.now.Add(d),
+		ch:       make(chan time.Time, 1),
+	}
+
+	if d <= 0 {
+		timer.ch <- c.now
+		return timer
+	}
+
+	c.timers = append(c.timers, timer)
+	c.changed.Broadcast()
+	return timer
+}
+
+// Advance moves the clock forward by the given duration and fires the timers that are due,
+// in the order of their deadlines.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	sort.SliceStable(c.timers, func(i, j int) bool {
+		return c.timers[i].deadline.Before(c.timers[j].deadline)
+	})
+
+	pending := c.timers[:0]
+	for _, timer := range c.timers {
+		if timer.deadline.After(c.now) {
+			pending = append(pending, timer)
+			continue
+		}
+
+		timer.ch <- c.now
+	}
+
+	c.timers = pending
+	c.changed.Broadcast()
+}
+
+// BlockUntil blocks until the given number of timers are waiting on the clock. It lets tests
+// wait for the scheduler to arm its timers before advancing the clock.
+func (c *FakeClock) BlockUntil(timers int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for len(c.timers) < timers {
+		c.changed.Wait()
+	}
+}
+
+// removeTimer removes the given timer from the waiting timers.
+func (c *FakeClock) removeTimer(timer *fakeTimer) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for index, waiting := range c.timers {
+		if waiting == timer {
+			c.timers = append(c.timers[:index], c.timers[index+1:]...)
+			c.changed.Broadcast()
+			return true
+		}
+	}
+
+	return false
+}
+
+// fakeTimer is a Timer created by a FakeClock.
+type fakeTimer struct {
+	clock    *FakeClock
+	deadline time.Time
+	ch       chan time.Time
+}
+
+// C returns the channel on which the time is delivered.
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.ch
+}
+
+// Stop prevents the timer from firing.
+func (t *fakeTimer) Stop() bool {
+	return t.clock.removeTimer(t)
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystemClock(t *testing.T) {
+	// given
+	clock := NewSystemClock()
+
+	// when
+	timer := clock.NewTimer(time.Millisecond)
+
+	// then
+	assert.WithinDuration(t, time.Now(), clock.Now(), time.Second)
+
+	select {
+	case <-timer.C():
+	case <-time.After(time.Second):
+		t.Fatal("timer did not fire")
+	}
+
+	assert.False(t, timer.Stop())
+}
+
+func TestFakeClock_Advance(t *testing.T) {
+	// given
+	clock := NewFakeClock(anyStartTime)
+	first := clock.NewTimer(time.Second)
+	second := clock.NewTimer(3 * time.Second)
+	stopped := clock.NewTimer(time.Second)
+
+	// when
+	assert.True(t, stopped.Stop())
+	clock.Advance(2 * time.Second)
+
+	// then
+	assert.Equal(t, anyStartTime.Add(2*time.Second), clock.Now())
+	assert.Equal(t, anyStartTime.Add(2*time.Second), <-first.C())
+	assert.Len(t, stopped.C(), 0)
+	assert.Len(t, second.C(), 0)
+	assert.False(t, first.Stop())
+
+	clock.Advance(time.Second)
+	assert.Equal(t, anyStartTime.Add(3*time.Second), <-second.C())
+}
+
+func TestFakeClock_NewTimer_NonPositiveDuration(t *testing.T) {
+	// given
+	clock := NewFakeClock(anyStartTime)
+
+	// when
+	timer := clock.NewTimer(0)
+
+	// then
+	assert.Equal(t, anyStartTime, <-timer.C())
+}
+
+func TestFakeClock_BlockUntil(t *testing.T) {
+	// given
+	clock := NewFakeClock(anyStartTime)
+
+	// when
+	go func() {
+		clock.NewTimer(time.Second)
+		clock.NewTimer(time.Second)
+	}()
+
+	// then
+	clock.BlockUntil(2)
+}
@@ -0,0 +1,366 @@
+// Copyright 2025 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronField describes the bounds and the names accepted by a field of a cron expression.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	secondField = cronField{name: "second", min: 0, max: 59}
+	minuteField = cronField{name: "minute", min: 0, max: 59}
+	hourField   = cronField{name: "hour", min: 0, max: 23}
+	domField    = cronField{name: "day of month", min: 1, max: 31}
+	monthField  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowField = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// cronMacros contains the predefined schedules that can be used instead of a cron expression.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// CronExpression represents a parsed cron expression. Each field is stored as a bit set
+// of the values it matches.
+type CronExpression struct {
+	expr     string
+	second   uint64
+	minute   uint64
+	hour     uint64
+	dom      uint64
+	month    uint64
+	dow      uint64
+	anyDay   bool
+	location *time.Location
+}
+
+// ParseCron parses the given cron expression. The expression consists of six space-separated
+// fields: second, minute, hour, day of month, month and day of week. Five fields are also
+// accepted, in which case the second defaults to zero. Each field supports wildcards (* or ?),
+// lists (1,2), ranges (1-5), steps (*/15 or 10-30/5) and, for months and days of week, names
+// such as JAN or MON. The predefined schedules @yearly, @monthly, @weekly, @daily and @hourly
+// can be used as well.
+//
+// The expression is evaluated in the given location, or in the location of the time passed
+// to Next if it is nil. A CRON_TZ= or TZ= prefix overrides the given location.
+func ParseCron(expr string, location *time.Location) (*CronExpression, error) {
+	spec := strings.TrimSpace(expr)
+	if spec == "" {
+		return nil, errors.New("empty cron expression")
+	}
+
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		zone, rest, _ := strings.Cut(spec, " ")
+		_, name, _ := strings.Cut(zone, "=")
+
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, fmt.Errorf("cron expression %q: invalid time zone %q: %w", expr, name, err)
+		}
+
+		location = loc
+		spec = strings.TrimSpace(rest)
+	}
+
+	if macro, ok := cronMacros[strings.ToLower(spec)]; ok {
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("cron expression %q: expected 5 or 6 fields, found %d", expr, len(fields))
+	}
+
+	cron := &CronExpression{
+		expr:     expr,
+		location: location,
+	}
+
+	var err error
+	targets := []struct {
+		bits  *uint64
+		field cronField
+	}{
+		{&cron.second, secondField},
+		{&cron.minute, minuteField},
+		{&cron.hour, hourField},
+		{&cron.dom, domField},
+		{&cron.month, monthField},
+		{&cron.dow, dowField},
+	}
+
+	for index, target := range targets {
+		*target.bits, err = parseCronField(fields[index], target.field)
+		if err != nil {
+			return nil, fmt.Errorf("cron expression %q: %w", expr, err)
+		}
+	}
+
+	// 7 is an alias for sunday
+	if cron.dow&(1<<7) != 0 {
+		cron.dow = cron.dow&^(1<<7) | 1
+	}
+
+	cron.anyDay = isWildcard(fields[3]) || isWildcard(fields[5])
+	return cron, nil
+}
+
+// MustParseCron is like ParseCron but panics if the expression cannot be parsed.
+func MustParseCron(expr string, location *time.Location) *CronExpression {
+	cron, err := ParseCron(expr, location)
+	if err != nil {
+		panic(err)
+	}
+
+	return cron
+}
+
+// String returns the original cron expression.
+func (c *CronExpression) String() string {
+	return c.expr
+}
+
+// Location returns the location the expression is evaluated in. It returns nil if the
+// location of the time passed to Next is used.
+func (c *CronExpression) Location() *time.Location {
+	return c.location
+}
+
+// Next returns the first time matching the expression that is strictly after the given time.
+// It returns the zero time if there is no such time within the next five years.
+func (c *CronExpression) Next(after time.Time) time.Time {
+	origLocation := after.Location()
+
+	location := c.location
+	if location == nil {
+		location = origLocation
+	}
+
+	t := after.In(location)
+	t = t.Add(time.Second - time.Duration(t.Nanosecond()))
+
+	// indicates whether a field has been incremented, in which case the lower fields are reset
+	added := false
+	yearLimit := t.Year() + 5
+
+wrap:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+
+	for c.month&(1<<uint(t.Month())) == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, location)
+		}
+
+		t = t.AddDate(0, 1, 0)
+		if t.Month() == time.January {
+			goto wrap
+		}
+	}
+
+	for !c.dayMatches(t) {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, location)
+		}
+
+		t = t.AddDate(0, 0, 1)
+
+		// daylight saving transitions may shift the midnight
+		if t.Hour() != 0 {
+			if t.Hour() > 12 {
+				t = t.Add(time.Duration(24-t.Hour()) * time.Hour)
+			} else {
+				t = t.Add(time.Duration(-t.Hour()) * time.Hour)
+			}
+		}
+
+		if t.Day() == 1 {
+			goto wrap
+		}
+	}
+
+	for c.hour&(1<<uint(t.Hour())) == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, location)
+		}
+
+		t = t.Add(time.Hour)
+		if t.Hour() == 0 {
+			goto wrap
+		}
+	}
+
+	for c.minute&(1<<uint(t.Minute())) == 0 {
+		if !added {
+			added = true
+			t = t.Truncate(time.Minute)
+		}
+
+		t = t.Add(time.Minute)
+		if t.Minute() == 0 {
+			goto wrap
+		}
+	}
+
+	for c.second&(1<<uint(t.Second())) == 0 {
+		if !added {
+			added = true
+			t = t.Truncate(time.Second)
+		}
+
+		t = t.Add(time.Second)
+		if t.Second() == 0 {
+			goto wrap
+		}
+	}
+
+	return t.In(origLocation)
+}
+
+// dayMatches reports whether the day of the given time matches the expression. If both the
+// day of month and the day of week are restricted, a day matching either of them matches.
+func (c *CronExpression) dayMatches(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+
+	if c.anyDay {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
+
+// parseCronField parses a comma-separated field of a cron expression into a bit set.
+func parseCronField(value string, field cronField) (uint64, error) {
+	var result uint64
+
+	for _, part := range strings.Split(value, ",") {
+		partBits, err := parseCronRange(part, field)
+		if err != nil {
+			return 0, err
+		}
+
+		result |= partBits
+	}
+
+	return result, nil
+}
+
+// parseCronRange parses a single wildcard, value or range with an optional step into a bit set.
+func parseCronRange(part string, field cronField) (uint64, error) {
+	rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+	var (
+		start, end int
+		err        error
+	)
+
+	switch {
+	case isWildcard(rangePart):
+		start, end = field.min, field.max
+	case strings.Contains(rangePart, "-"):
+		startPart, endPart, _ := strings.Cut(rangePart, "-")
+
+		if start, err = parseCronValue(startPart, field); err != nil {
+			return 0, err
+		}
+
+		if end, err = parseCronValue(endPart, field); err != nil {
+			return 0, err
+		}
+	default:
+		if start, err = parseCronValue(rangePart, field); err != nil {
+			return 0, err
+		}
+
+		end = start
+		if hasStep {
+			end = field.max
+		}
+	}
+
+	if start > end {
+		return 0, fmt.Errorf("invalid %s range '%s': start is greater than end", field.name, part)
+	}
+
+	step := 1
+	if hasStep {
+		step, err = strconv.Atoi(stepPart)
+		if err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid %s step '%s'", field.name, stepPart)
+		}
+	}
+
+	var result uint64
+	for value := start; value <= end; value += step {
+		result |= 1 << uint(value)
+	}
+
+	return result, nil
+}
+
+// parseCronValue parses a single numeric or named value of a field and checks its bounds.
+func parseCronValue(value string, field cronField) (int, error) {
+	if number, ok := field.names[strings.ToLower(value)]; ok {
+		return number, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s '%s'", field.name, value)
+	}
+
+	if number < field.min || number > field.max {
+		return 0, fmt.Errorf("%s '%d' out of range [%d, %d]", field.name, number, field.min, field.max)
+	}
+
+	return number, nil
+}
+
+// isWildcard reports whether the given field value matches any value.
+func isWildcard(value string) bool {
+	return value == "*" || value == "?"
+}
@@ -0,0 +1,239 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	testCases := []struct {
+		name    string
+		expr    string
+		wantErr error
+	}{
+		{
+			name:    "empty expression",
+			expr:    " ",
+			wantErr: errors.New("empty cron expression"),
+		},
+		{
+			name:    "wrong number of fields",
+			expr:    "* * * *",
+			wantErr: errors.New("cron expression \"* * * *\": expected 5 or 6 fields, found 4"),
+		},
+		{
+			name:    "value out of range",
+			expr:    "60 * * * * *",
+			wantErr: errors.New("cron expression \"60 * * * * *\": second '60' out of range [0, 59]"),
+		},
+		{
+			name:    "invalid value",
+			expr:    "0 x * * * *",
+			wantErr: errors.New("cron expression \"0 x * * * *\": invalid minute 'x'"),
+		},
+		{
+			name:    "invalid range",
+			expr:    "0 0 5-1 * * *",
+			wantErr: errors.New("cron expression \"0 0 5-1 * * *\": invalid hour range '5-1': start is greater than end"),
+		},
+		{
+			name:    "invalid step",
+			expr:    "*/0 * * * * *",
+			wantErr: errors.New("cron expression \"*/0 * * * * *\": invalid second step '0'"),
+		},
+		{
+			name:    "invalid time zone",
+			expr:    "CRON_TZ=Mars/Olympus 0 0 * * * *",
+			wantErr: errors.New("cron expression \"CRON_TZ=Mars/Olympus 0 0 * * * *\": invalid time zone \"Mars/Olympus\": unknown time zone Mars/Olympus"),
+		},
+		{
+			name: "six fields",
+			expr: "*/10 0-30/5 1,2 ? JAN-jun mon-FRI",
+		},
+		{
+			name: "five fields",
+			expr: "0 9 * * *",
+		},
+		{
+			name: "macro",
+			expr: "@daily",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+
+			// when
+			cron, err := ParseCron(tc.expr, nil)
+
+			// then
+			if tc.wantErr != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expr, cron.String())
+		})
+	}
+}
+
+func TestMustParseCron(t *testing.T) {
+	assert.Panics(t, func() {
+		MustParseCron("* *", nil)
+	})
+
+	assert.NotNil(t, MustParseCron("@hourly", time.UTC))
+}
+
+func TestCronExpression_Next(t *testing.T) {
+	istanbul, err := time.LoadLocation("Europe/Istanbul")
+	require.NoError(t, err)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		expr     string
+		location *time.Location
+		after    time.Time
+		want     []time.Time
+	}{
+		{
+			name:  "every ten seconds",
+			expr:  "*/10 * * * * *",
+			after: time.Date(2025, 1, 1, 10, 0, 5, 500, time.UTC),
+			want: []time.Time{
+				time.Date(2025, 1, 1, 10, 0, 10, 0, time.UTC),
+				time.Date(2025, 1, 1, 10, 0, 20, 0, time.UTC),
+			},
+		},
+		{
+			name:  "strictly after the given time",
+			expr:  "0 0 * * * *",
+			after: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "week days at nine",
+			expr:  "0 0 9 * * MON-FRI",
+			after: time.Date(2025, 1, 3, 10, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 7, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "end of year wrap",
+			expr:  "0 30 0 1 1 *",
+			after: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2026, 1, 1, 0, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "day of month or day of week",
+			expr:  "0 0 0 15 * SUN",
+			after: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 19, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "sunday as seven",
+			expr:  "0 0 0 * * 7",
+			after: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "leap day",
+			expr:  "0 0 0 29 2 *",
+			after: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "never",
+			expr:  "0 0 0 30 2 *",
+			after: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				{},
+			},
+		},
+		{
+			name:     "location",
+			expr:     "0 0 9 * * *",
+			location: istanbul,
+			after:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "time zone prefix",
+			expr:  "TZ=Europe/Istanbul 0 0 9 * * *",
+			after: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "daylight saving time",
+			expr:     "0 0 12 * * *",
+			location: newYork,
+			after:    time.Date(2025, 3, 8, 18, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2025, 3, 9, 16, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			cron, err := ParseCron(tc.expr, tc.location)
+			require.NoError(t, err)
+
+			// when
+			got := make([]time.Time, 0, len(tc.want))
+			next := tc.after
+			for range tc.want {
+				next = cron.Next(next)
+				got = append(got, next)
+			}
+
+			// then
+			for index, want := range tc.want {
+				assert.True(t, want.Equal(got[index]), "want %s, got %s", want, got[index])
+			}
+		})
+	}
+}
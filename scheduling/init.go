@@ -12,11 +12,10 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package component
+package scheduling
 
-import "codnect.io/logy"
+import "codnect.io/procyon/component"
 
-var (
-	// log is the package-level logger.
-	log = logy.Get()
-)
+func init() {
+	component.Register(NewTaskScheduler)
+}
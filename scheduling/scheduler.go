@@ -0,0 +1,320 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"codnect.io/procyon/component"
+	"codnect.io/procyon/runtime"
+)
+
+// scheduledTask holds a task along with its resolved trigger.
+type scheduledTask struct {
+	task    Task
+	trigger Trigger
+}
+
+// TaskScheduler runs the scheduled tasks declared by the ScheduledTasks components and the
+// tasks registered using Schedule. It implements runtime.Lifecycle, so it starts with the
+// application context and stops on shutdown, waiting for the running executions to finish.
+type TaskScheduler struct {
+	container component.Container
+	env       runtime.Environment
+	clock     Clock
+
+	tasks   []Task
+	names   map[string]struct{}
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	running bool
+	mu      sync.Mutex
+}
+
+// NewTaskScheduler creates a new TaskScheduler with the given container and environment.
+// The environment is used to resolve the placeholders in the trigger definitions.
+func NewTaskScheduler(container component.Container, env runtime.Environment) *TaskScheduler {
+	if container == nil {
+		panic("nil container")
+	}
+
+	if env == nil {
+		panic("nil environment")
+	}
+
+	return &TaskScheduler{
+		container: container,
+		env:       env,
+		clock:     NewSystemClock(),
+		names:     make(map[string]struct{}),
+	}
+}
+
+// SetClock sets the clock used to schedule the tasks. It must be called before the scheduler starts.
+func (s *TaskScheduler) SetClock(clock Clock) error {
+	if clock == nil {
+		return errors.New("nil clock")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		return errors.New("task scheduler is already running")
+	}
+
+	s.clock = clock
+	return nil
+}
+
+// Schedule registers the given task. If the scheduler is already running, the task is
+// scheduled immediately. Otherwise, it is scheduled when the scheduler starts.
+// The registered tasks are scheduled again if the scheduler is restarted.
+func (s *TaskScheduler) Schedule(task Task) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.validate(task, s.names); err != nil {
+		return err
+	}
+
+	if s.running {
+		scheduled, err := s.prepare(task)
+		if err != nil {
+			return err
+		}
+
+		s.start(scheduled)
+	}
+
+	s.tasks = append(s.tasks, task)
+	s.names[task.Name] = struct{}{}
+	return nil
+}
+
+// Start schedules the registered tasks and the tasks declared by the ScheduledTasks components.
+// It returns an error if a task is invalid, in which case no task is scheduled.
+func (s *TaskScheduler) Start(ctx context.Context) error {
+	if ctx == nil {
+		return errors.New("nil context")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		return nil
+	}
+
+	declarers, err := component.ResolveAll[ScheduledTasks](ctx, s.container)
+	if err != nil {
+		return fmt.Errorf("resolve scheduled tasks: %w", err)
+	}
+
+	tasks := slices.Clone(s.tasks)
+	names := maps.Clone(s.names)
+
+	for _, declarer := range declarers {
+		for _, task := range declarer.ScheduledTasks() {
+			if err = s.validate(task, names); err != nil {
+				return err
+			}
+
+			names[task.Name] = struct{}{}
+			tasks = append(tasks, task)
+		}
+	}
+
+	scheduledTasks := make([]*scheduledTask, 0, len(tasks))
+	for _, task := range tasks {
+		scheduled, prepareErr := s.prepare(task)
+		if prepareErr != nil {
+			return prepareErr
+		}
+
+		scheduledTasks = append(scheduledTasks, scheduled)
+	}
+
+	s.names = names
+
+	// the executions outlive the start context, but keep its values
+	s.ctx, s.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	s.running = true
+
+	for _, scheduled := range scheduledTasks {
+		s.start(scheduled)
+	}
+
+	return nil
+}
+
+// Stop stops scheduling the tasks and waits for the running executions to finish. The executions
+// are notified through the cancellation of their context. If the given context is done before
+// they finish, Stop returns its error.
+func (s *TaskScheduler) Stop(ctx context.Context) error {
+	if ctx == nil {
+		return errors.New("nil context")
+	}
+
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		return nil
+	}
+
+	s.running = false
+	s.cancel()
+
+	// the declared tasks are resolved again on the next start
+	s.names = make(map[string]struct{}, len(s.tasks))
+	for _, task := range s.tasks {
+		s.names[task.Name] = struct{}{}
+	}
+
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("stop task scheduler: %w", ctx.Err())
+	}
+}
+
+// IsRunning indicates whether the scheduler is running.
+func (s *TaskScheduler) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+// validate checks the function of the given task and whether its name is unique among the given names.
+func (s *TaskScheduler) validate(task Task, names map[string]struct{}) error {
+	if task.Name == "" {
+		return errors.New("empty task name")
+	}
+
+	if task.Func == nil {
+		return fmt.Errorf("task %q: nil task func", task.Name)
+	}
+
+	if _, exists := names[task.Name]; exists {
+		return fmt.Errorf("task %q: duplicate task name", task.Name)
+	}
+
+	return nil
+}
+
+// prepare resolves the trigger of the given task.
+func (s *TaskScheduler) prepare(task Task) (*scheduledTask, error) {
+	trigger, err := task.resolveTrigger(s.env.PropertyResolver())
+	if err != nil {
+		return nil, fmt.Errorf("task %q: %w", task.Name, err)
+	}
+
+	return &scheduledTask{
+		task:    task,
+		trigger: trigger,
+	}, nil
+}
+
+// start starts the scheduling loop of the given task.
+func (s *TaskScheduler) start(scheduled *scheduledTask) {
+	ctx := s.ctx
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+		s.loop(ctx, scheduled)
+	}()
+
+	slog.Debug("Scheduled task", "task", scheduled.task.Name)
+}
+
+// loop waits for the next execution time of the given task and runs it until the context
+// is canceled or the trigger does not return a next execution time anymore. Unless the task
+// is concurrent, the next execution time is determined once the previous execution completes,
+// so the executions never overlap.
+func (s *TaskScheduler) loop(ctx context.Context, scheduled *scheduledTask) {
+	tc := TriggerContext{}
+
+	for {
+		tc.Now = s.clock.Now()
+
+		next := scheduled.trigger.Next(tc)
+		if next.IsZero() {
+			return
+		}
+
+		timer := s.clock.NewTimer(next.Sub(tc.Now))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C():
+		}
+
+		tc.LastScheduled = next
+
+		if scheduled.task.Concurrent {
+			s.wg.Add(1)
+
+			go func() {
+				defer s.wg.Done()
+				s.execute(ctx, scheduled.task)
+			}()
+
+			continue
+		}
+
+		s.execute(ctx, scheduled.task)
+		tc.LastCompleted = s.clock.Now()
+	}
+}
+
+// execute runs the given task once. Errors and panics are logged, and do not prevent the
+// following executions.
+func (s *TaskScheduler) execute(ctx context.Context, task Task) {
+	start := s.clock.Now()
+
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("Scheduled task panicked", "task", task.Name, "panic", r)
+		}
+	}()
+
+	if err := task.Func(ctx); err != nil {
+		slog.Error("Scheduled task failed", "task", task.Name, "error", err)
+		return
+	}
+
+	slog.Debug("Scheduled task completed", "task", task.Name,
+		"duration", s.clock.Now().Sub(start).Round(time.Millisecond))
+}
@@ -0,0 +1,646 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"codnect.io/procyon/component"
+	"codnect.io/procyon/runtime"
+	"codnect.io/procyon/runtime/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type anyEnvironment struct {
+	propSources *config.PropertySources
+}
+
+func newAnyEnvironment(props map[string]any) *anyEnvironment {
+	if props == nil {
+		props = make(map[string]any)
+	}
+
+	return &anyEnvironment{
+		propSources: config.NewPropertySources(config.NewMapPropertySource("anyPropertySource", props)),
+	}
+}
+
+func (e *anyEnvironment) ActiveProfiles() []string {
+	return nil
+}
+
+func (e *anyEnvironment) DefaultProfiles() []string {
+	return nil
+}
+
+func (e *anyEnvironment) IsProfileActive(profile string) bool {
+	return false
+}
+
+func (e *anyEnvironment) SetActiveProfiles(profiles ...string) error {
+	return nil
+}
+
+func (e *anyEnvironment) AddActiveProfiles(profiles ...string) error {
+	return nil
+}
+
+func (e *anyEnvironment) SetDefaultProfiles(profiles ...string) error {
+	return nil
+}
+
+func (e *anyEnvironment) PropertySources() *config.PropertySources {
+	return e.propSources
+}
+
+func (e *anyEnvironment) PropertyResolver() config.PropertyResolver {
+	return config.NewDefaultPropertyResolver(e.propSources)
+}
+
+type anyScheduledTasks struct {
+	tasks []Task
+}
+
+func (s *anyScheduledTasks) ScheduledTasks() []Task {
+	return s.tasks
+}
+
+type anyContextKey struct{}
+
+func newAnyTaskScheduler(t *testing.T, props map[string]any, declared ...Task) (*TaskScheduler, *FakeClock) {
+	container := component.NewStandardContainer()
+	if len(declared) != 0 {
+		err := container.RegisterSingleton("anyScheduledTasks", &anyScheduledTasks{tasks: declared})
+		require.NoError(t, err)
+	}
+
+	clock := NewFakeClock(anyStartTime)
+	scheduler := NewTaskScheduler(container, newAnyEnvironment(props))
+	require.NoError(t, scheduler.SetClock(clock))
+
+	t.Cleanup(func() {
+		_ = scheduler.Stop(context.Background())
+	})
+
+	return scheduler, clock
+}
+
+func TestNewTaskScheduler(t *testing.T) {
+	testCases := []struct {
+		name      string
+		container component.Container
+		env       runtime.Environment
+		wantPanic error
+	}{
+		{
+			name:      "nil container",
+			env:       newAnyEnvironment(nil),
+			wantPanic: errors.New("nil container"),
+		},
+		{
+			name:      "nil environment",
+			container: component.NewStandardContainer(),
+			wantPanic: errors.New("nil environment"),
+		},
+		{
+			name:      "valid arguments",
+			container: component.NewStandardContainer(),
+			env:       newAnyEnvironment(nil),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+
+			// when
+			if tc.wantPanic != nil {
+				require.PanicsWithValue(t, tc.wantPanic.Error(), func() {
+					NewTaskScheduler(tc.container, tc.env)
+				})
+				return
+			}
+
+			scheduler := NewTaskScheduler(tc.container, tc.env)
+
+			// then
+			require.NotNil(t, scheduler)
+			assert.False(t, scheduler.IsRunning())
+		})
+	}
+}
+
+func TestTaskScheduler_SetClock(t *testing.T) {
+	// given
+	scheduler, _ := newAnyTaskScheduler(t, nil)
+
+	// when
+	nilErr := scheduler.SetClock(nil)
+	require.NoError(t, scheduler.Start(context.Background()))
+	runningErr := scheduler.SetClock(NewSystemClock())
+
+	// then
+	assert.EqualError(t, nilErr, "nil clock")
+	assert.EqualError(t, runningErr, "task scheduler is already running")
+}
+
+func TestTaskScheduler_Schedule(t *testing.T) {
+	noop := func(ctx context.Context) error {
+		return nil
+	}
+
+	testCases := []struct {
+		name    string
+		task    Task
+		wantErr error
+	}{
+		{
+			name:    "empty name",
+			task:    Task{Func: noop, FixedRate: "1s"},
+			wantErr: errors.New("empty task name"),
+		},
+		{
+			name:    "nil func",
+			task:    Task{Name: "anyTask", FixedRate: "1s"},
+			wantErr: errors.New("task \"anyTask\": nil task func"),
+		},
+		{
+			name:    "duplicate name",
+			task:    Task{Name: "existingTask", Func: noop, FixedRate: "1s"},
+			wantErr: errors.New("task \"existingTask\": duplicate task name"),
+		},
+		{
+			name: "valid task",
+			task: Task{Name: "anyTask", Func: noop, FixedRate: "1s"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			scheduler, _ := newAnyTaskScheduler(t, nil)
+			require.NoError(t, scheduler.Schedule(Task{Name: "existingTask", Func: noop, FixedRate: "1s"}))
+
+			// when
+			err := scheduler.Schedule(tc.task)
+
+			// then
+			if tc.wantErr != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTaskScheduler_Start(t *testing.T) {
+	noop := func(ctx context.Context) error {
+		return nil
+	}
+
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		declared []Task
+		task     Task
+		wantErr  error
+	}{
+		{
+			name:    "nil context",
+			wantErr: errors.New("nil context"),
+		},
+		{
+			name:    "no trigger",
+			ctx:     context.Background(),
+			task:    Task{Name: "anyTask", Func: noop},
+			wantErr: errors.New("task \"anyTask\": no trigger defined"),
+		},
+		{
+			name:    "multiple triggers",
+			ctx:     context.Background(),
+			task:    Task{Name: "anyTask", Func: noop, Cron: "@daily", FixedRate: "1s"},
+			wantErr: errors.New("task \"anyTask\": exactly one of cron, fixed rate, fixed delay and trigger must be defined"),
+		},
+		{
+			name:    "unresolved placeholder",
+			ctx:     context.Background(),
+			task:    Task{Name: "anyTask", Func: noop, Cron: "${jobs.missing.cron}"},
+			wantErr: errors.New("task \"anyTask\": resolve cron: expand \"${jobs.missing.cron}\": unresolved placeholder ${jobs.missing.cron}"),
+		},
+		{
+			name:    "invalid cron",
+			ctx:     context.Background(),
+			task:    Task{Name: "anyTask", Func: noop, Cron: "* *"},
+			wantErr: errors.New("task \"anyTask\": cron expression \"* *\": expected 5 or 6 fields, found 2"),
+		},
+		{
+			name:    "invalid zone",
+			ctx:     context.Background(),
+			task:    Task{Name: "anyTask", Func: noop, Cron: "@daily", Zone: "Mars/Olympus"},
+			wantErr: errors.New("task \"anyTask\": invalid zone 'Mars/Olympus': unknown time zone Mars/Olympus"),
+		},
+		{
+			name:    "cron with initial delay",
+			ctx:     context.Background(),
+			task:    Task{Name: "anyTask", Func: noop, Cron: "@daily", InitialDelay: "1s"},
+			wantErr: errors.New("task \"anyTask\": initial delay is not supported for cron tasks"),
+		},
+		{
+			name:    "invalid fixed rate",
+			ctx:     context.Background(),
+			task:    Task{Name: "anyTask", Func: noop, FixedRate: "fast"},
+			wantErr: errors.New("task \"anyTask\": invalid fixed rate 'fast': time: invalid duration \"fast\""),
+		},
+		{
+			name:    "blank cron with fixed rate",
+			ctx:     context.Background(),
+			task:    Task{Name: "anyTask", Func: noop, Cron: "  ", FixedRate: "fast"},
+			wantErr: errors.New("task \"anyTask\": invalid fixed rate 'fast': time: invalid duration \"fast\""),
+		},
+		{
+			name:    "zero fixed rate",
+			ctx:     context.Background(),
+			task:    Task{Name: "anyTask", Func: noop, FixedRate: "0s"},
+			wantErr: errors.New("task \"anyTask\": fixed rate '0s' must be positive"),
+		},
+		{
+			name:    "negative initial delay",
+			ctx:     context.Background(),
+			task:    Task{Name: "anyTask", Func: noop, FixedDelay: "1s", InitialDelay: "-1s"},
+			wantErr: errors.New("task \"anyTask\": initial delay '-1s' must not be negative"),
+		},
+		{
+			name:    "concurrent fixed delay",
+			ctx:     context.Background(),
+			task:    Task{Name: "anyTask", Func: noop, FixedDelay: "1s", Concurrent: true},
+			wantErr: errors.New("task \"anyTask\": fixed delay tasks cannot run concurrently"),
+		},
+		{
+			name:     "duplicate declared task",
+			ctx:      context.Background(),
+			declared: []Task{{Name: "anyTask", Func: noop, FixedRate: "1s"}},
+			task:     Task{Name: "anyTask", Func: noop, FixedRate: "1s"},
+			wantErr:  errors.New("task \"anyTask\": duplicate task name"),
+		},
+		{
+			name:     "valid tasks",
+			ctx:      context.Background(),
+			declared: []Task{{Name: "declaredTask", Func: noop, Cron: "${jobs.cleanup.cron}", Zone: "${jobs.cleanup.zone}"}},
+			task:     Task{Name: "anyTask", Func: noop, Trigger: NewFixedRateTrigger(time.Second, 0)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			scheduler, _ := newAnyTaskScheduler(t, map[string]any{
+				"jobs.cleanup.cron": "0 0 3 * * *",
+				"jobs.cleanup.zone": "UTC",
+			}, tc.declared...)
+
+			if tc.task.Name != "" {
+				require.NoError(t, scheduler.Schedule(tc.task))
+			}
+
+			// when
+			err := scheduler.Start(tc.ctx)
+
+			// then
+			if tc.wantErr != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.wantErr.Error())
+				assert.False(t, scheduler.IsRunning())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, scheduler.IsRunning())
+		})
+	}
+}
+
+func TestTaskScheduler_FixedRate(t *testing.T) {
+	// given
+	var clock *FakeClock
+	executions := make(chan time.Time)
+
+	scheduler, clock := newAnyTaskScheduler(t, map[string]any{
+		"jobs.cleanup.rate":  "5s",
+		"jobs.cleanup.delay": "1s",
+	}, Task{
+		Name:         "cleanupTask",
+		FixedRate:    "${jobs.cleanup.rate}",
+		InitialDelay: "${jobs.cleanup.delay}",
+		Func: func(ctx context.Context) error {
+			executions <- clock.Now()
+			return nil
+		},
+	})
+
+	// when
+	require.NoError(t, scheduler.Start(context.Background()))
+
+	// then
+	clock.BlockUntil(1)
+	clock.Advance(time.Second)
+	assert.Equal(t, anyStartTime.Add(time.Second), <-executions)
+
+	clock.BlockUntil(1)
+	clock.Advance(5 * time.Second)
+	assert.Equal(t, anyStartTime.Add(6*time.Second), <-executions)
+}
+
+func TestTaskScheduler_FixedDelay(t *testing.T) {
+	// given
+	executions := make(chan time.Time)
+	release := make(chan struct{})
+
+	scheduler, clock := newAnyTaskScheduler(t, nil)
+	err := scheduler.Schedule(Task{
+		Name:       "anyTask",
+		FixedDelay: "5s",
+		Func: func(ctx context.Context) error {
+			executions <- clock.Now()
+			<-release
+			return nil
+		},
+	})
+	require.NoError(t, err)
+
+	// when
+	require.NoError(t, scheduler.Start(context.Background()))
+
+	// then
+	assert.Equal(t, anyStartTime, <-executions)
+	clock.Advance(3 * time.Second)
+	release <- struct{}{}
+
+	clock.BlockUntil(1)
+	clock.Advance(5 * time.Second)
+	assert.Equal(t, anyStartTime.Add(8*time.Second), <-executions)
+	close(release)
+}
+
+func TestTaskScheduler_PreventsOverlappingExecutions(t *testing.T) {
+	// given
+	var running, overlaps, calls atomic.Int32
+	executions := make(chan time.Time)
+	release := make(chan struct{})
+
+	scheduler, clock := newAnyTaskScheduler(t, nil)
+	err := scheduler.Schedule(Task{
+		Name:      "anyTask",
+		FixedRate: "1s",
+		Func: func(ctx context.Context) error {
+			if running.Add(1) > 1 {
+				overlaps.Add(1)
+			}
+
+			defer running.Add(-1)
+
+			calls.Add(1)
+			executions <- clock.Now()
+			<-release
+			return nil
+		},
+	})
+	require.NoError(t, err)
+
+	// when
+	require.NoError(t, scheduler.Start(context.Background()))
+
+	// then
+	assert.Equal(t, anyStartTime, <-executions)
+	clock.Advance(3500 * time.Millisecond)
+	release <- struct{}{}
+
+	clock.BlockUntil(1)
+	clock.Advance(500 * time.Millisecond)
+	assert.Equal(t, anyStartTime.Add(4*time.Second), <-executions)
+	close(release)
+
+	assert.Equal(t, int32(2), calls.Load())
+	assert.Zero(t, overlaps.Load())
+}
+
+func TestTaskScheduler_ConcurrentExecutions(t *testing.T) {
+	// given
+	var running atomic.Int32
+	started := make(chan int32)
+	release := make(chan struct{})
+
+	scheduler, clock := newAnyTaskScheduler(t, nil)
+	err := scheduler.Schedule(Task{
+		Name:       "anyTask",
+		FixedRate:  "1s",
+		Concurrent: true,
+		Func: func(ctx context.Context) error {
+			started <- running.Add(1)
+			<-release
+			running.Add(-1)
+			return nil
+		},
+	})
+	require.NoError(t, err)
+
+	// when
+	require.NoError(t, scheduler.Start(context.Background()))
+
+	// then
+	assert.Equal(t, int32(1), <-started)
+	clock.BlockUntil(1)
+	clock.Advance(time.Second)
+	assert.Equal(t, int32(2), <-started)
+	close(release)
+}
+
+func TestTaskScheduler_Cron(t *testing.T) {
+	// given
+	executions := make(chan time.Time)
+	scheduler, clock := newAnyTaskScheduler(t, map[string]any{
+		"jobs.report.cron": "0 0 9 * * MON-FRI",
+	})
+
+	err := scheduler.Schedule(Task{
+		Name: "reportTask",
+		Cron: "${jobs.report.cron}",
+		Zone: "Europe/Istanbul",
+		Func: func(ctx context.Context) error {
+			executions <- clock.Now()
+			return nil
+		},
+	})
+	require.NoError(t, err)
+
+	// when
+	require.NoError(t, scheduler.Start(context.Background()))
+
+	// then
+	clock.BlockUntil(1)
+	clock.Advance(6 * time.Hour)
+	assert.Equal(t, anyStartTime.Add(6*time.Hour), <-executions)
+
+	clock.BlockUntil(1)
+	clock.Advance(24 * time.Hour)
+	assert.Equal(t, anyStartTime.Add(30*time.Hour), <-executions)
+}
+
+func TestTaskScheduler_ContinuesAfterFailures(t *testing.T) {
+	// given
+	var calls atomic.Int32
+	executions := make(chan any, 1)
+
+	scheduler, clock := newAnyTaskScheduler(t, nil)
+	err := scheduler.Schedule(Task{
+		Name:      "anyTask",
+		FixedRate: "1s",
+		Func: func(ctx context.Context) error {
+			defer func() {
+				executions <- ctx.Value(anyContextKey{})
+			}()
+
+			switch calls.Add(1) {
+			case 1:
+				return errors.New("any error")
+			case 2:
+				panic("any panic")
+			}
+
+			return nil
+		},
+	})
+	require.NoError(t, err)
+
+	// when
+	ctx := context.WithValue(context.Background(), anyContextKey{}, "anyValue")
+	require.NoError(t, scheduler.Start(ctx))
+
+	// then
+	for range 3 {
+		assert.Equal(t, "anyValue", <-executions)
+		clock.BlockUntil(1)
+		clock.Advance(time.Second)
+	}
+
+	assert.GreaterOrEqual(t, calls.Load(), int32(3))
+}
+
+func TestTaskScheduler_Stop(t *testing.T) {
+	// given
+	started := make(chan struct{})
+	scheduler, _ := newAnyTaskScheduler(t, nil)
+
+	err := scheduler.Schedule(Task{
+		Name:      "anyTask",
+		FixedRate: "1s",
+		Func: func(ctx context.Context) error {
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, scheduler.Start(context.Background()))
+	<-started
+
+	// when
+	nilCtxErr := scheduler.Stop(nil)
+	err = scheduler.Stop(context.Background())
+
+	// then
+	assert.EqualError(t, nilCtxErr, "nil context")
+	require.NoError(t, err)
+	assert.False(t, scheduler.IsRunning())
+	require.NoError(t, scheduler.Stop(context.Background()))
+}
+
+func TestTaskScheduler_Stop_Timeout(t *testing.T) {
+	// given
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+
+	scheduler, _ := newAnyTaskScheduler(t, nil)
+	err := scheduler.Schedule(Task{
+		Name:      "anyTask",
+		FixedRate: "1s",
+		Func: func(ctx context.Context) error {
+			close(started)
+			<-release
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, scheduler.Start(context.Background()))
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// when
+	err = scheduler.Stop(ctx)
+
+	// then
+	require.Error(t, err)
+	assert.EqualError(t, err, "stop task scheduler: context deadline exceeded")
+}
+
+func TestTaskScheduler_Restart(t *testing.T) {
+	// given
+	executions := make(chan string, 4)
+	scheduler, clock := newAnyTaskScheduler(t, nil, Task{
+		Name:      "declaredTask",
+		FixedRate: "1s",
+		Func: func(ctx context.Context) error {
+			executions <- "declaredTask"
+			return nil
+		},
+	})
+
+	require.NoError(t, scheduler.Start(context.Background()))
+	assert.Equal(t, "declaredTask", <-executions)
+	clock.BlockUntil(1)
+
+	err := scheduler.Schedule(Task{
+		Name:         "registeredTask",
+		FixedRate:    "1s",
+		InitialDelay: "1h",
+		Func: func(ctx context.Context) error {
+			executions <- "registeredTask"
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	clock.BlockUntil(2)
+	require.NoError(t, scheduler.Stop(context.Background()))
+
+	// when
+	err = scheduler.Start(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "declaredTask", <-executions)
+	clock.BlockUntil(2)
+	clock.Advance(time.Hour)
+	assert.Contains(t, []string{"declaredTask", "registeredTask"}, <-executions)
+}
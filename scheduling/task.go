@@ -0,0 +1,185 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"codnect.io/procyon/runtime/config"
+)
+
+// TaskFunc is the function run by a scheduled task.
+type TaskFunc func(ctx context.Context) error
+
+// Task defines a scheduled task and its trigger. Exactly one of Cron, FixedRate, FixedDelay
+// and Trigger must be set.
+//
+// The trigger definitions are strings so that they can be overridden from the properties
+// using placeholders, such as "${jobs.cleanup.cron}" or "${jobs.cleanup.rate}".
+// The durations are in the format accepted by time.ParseDuration.
+type Task struct {
+	// Name is the unique name of the task.
+	Name string
+	// Func is the function run on each execution.
+	Func TaskFunc
+	// Cron is a cron expression with seconds, see ParseCron.
+	Cron string
+	// Zone is the time zone the cron expression is evaluated in. The local time zone is used if it is empty.
+	Zone string
+	// FixedRate is the period between the scheduled times of successive executions.
+	FixedRate string
+	// FixedDelay is the delay between the completion of an execution and the start of the next one.
+	FixedDelay string
+	// InitialDelay is the delay before the first execution of a fixed rate or fixed delay task.
+	InitialDelay string
+	// Trigger is a custom trigger used instead of the trigger definitions.
+	Trigger Trigger
+	// Concurrent allows an execution to start while the previous one is still running.
+	// By default, overlapping executions are prevented.
+	Concurrent bool
+}
+
+// ScheduledTasks interface is implemented by components that declare scheduled tasks.
+// The tasks are scheduled when the task scheduler starts.
+type ScheduledTasks interface {
+	// ScheduledTasks returns the tasks to be scheduled.
+	ScheduledTasks() []Task
+}
+
+// resolveTrigger expands the placeholders in the trigger definitions of the task using
+// the given resolver and creates the trigger they define.
+func (t Task) resolveTrigger(resolver config.PropertyResolver) (Trigger, error) {
+	cron := strings.TrimSpace(t.Cron)
+	fixedRate := strings.TrimSpace(t.FixedRate)
+	fixedDelay := strings.TrimSpace(t.FixedDelay)
+
+	definitions := 0
+	for _, definition := range []string{cron, fixedRate, fixedDelay} {
+		if definition != "" {
+			definitions++
+		}
+	}
+
+	if t.Trigger != nil {
+		definitions++
+	}
+
+	if definitions == 0 {
+		return nil, errors.New("no trigger defined")
+	}
+
+	if definitions > 1 {
+		return nil, errors.New("exactly one of cron, fixed rate, fixed delay and trigger must be defined")
+	}
+
+	if t.Trigger != nil {
+		return t.Trigger, nil
+	}
+
+	initialDelay, err := resolveDuration(resolver, "initial delay", t.InitialDelay)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case cron != "":
+		if initialDelay != 0 {
+			return nil, errors.New("initial delay is not supported for cron tasks")
+		}
+
+		return resolveCronTrigger(resolver, cron, t.Zone)
+	case fixedRate != "":
+		period, resolveErr := resolveDuration(resolver, "fixed rate", fixedRate)
+		if resolveErr != nil {
+			return nil, resolveErr
+		}
+
+		if period <= 0 {
+			return nil, fmt.Errorf("fixed rate '%s' must be positive", period)
+		}
+
+		return NewFixedRateTrigger(period, initialDelay), nil
+	default:
+		if t.Concurrent {
+			return nil, errors.New("fixed delay tasks cannot run concurrently")
+		}
+
+		delay, resolveErr := resolveDuration(resolver, "fixed delay", fixedDelay)
+		if resolveErr != nil {
+			return nil, resolveErr
+		}
+
+		if delay <= 0 {
+			return nil, fmt.Errorf("fixed delay '%s' must be positive", delay)
+		}
+
+		return NewFixedDelayTrigger(delay, initialDelay), nil
+	}
+}
+
+// resolveCronTrigger expands the given cron expression and time zone and creates a CronTrigger.
+func resolveCronTrigger(resolver config.PropertyResolver, cron string, zone string) (Trigger, error) {
+	expr, err := resolver.ExpandStrict(cron)
+	if err != nil {
+		return nil, fmt.Errorf("resolve cron: %w", err)
+	}
+
+	location := time.Local
+	if zone != "" {
+		zone, err = resolver.ExpandStrict(zone)
+		if err != nil {
+			return nil, fmt.Errorf("resolve zone: %w", err)
+		}
+
+		location, err = time.LoadLocation(zone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid zone '%s': %w", zone, err)
+		}
+	}
+
+	cronExpr, err := ParseCron(expr, location)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCronTrigger(cronExpr), nil
+}
+
+// resolveDuration expands the given duration definition and parses it. An empty definition is zero.
+func resolveDuration(resolver config.PropertyResolver, name string, definition string) (time.Duration, error) {
+	if strings.TrimSpace(definition) == "" {
+		return 0, nil
+	}
+
+	value, err := resolver.ExpandStrict(definition)
+	if err != nil {
+		return 0, fmt.Errorf("resolve %s: %w", name, err)
+	}
+
+	duration, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s '%s': %w", name, value, err)
+	}
+
+	if duration < 0 {
+		return 0, fmt.Errorf("%s '%s' must not be negative", name, value)
+	}
+
+	return duration, nil
+}
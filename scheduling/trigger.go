@@ -0,0 +1,149 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"fmt"
+	"time"
+)
+
+// TriggerContext holds the execution times of a task, which a Trigger uses to determine
+// the next execution time.
+type TriggerContext struct {
+	// Now is the current time.
+	Now time.Time
+	// LastScheduled is the time the last execution was scheduled for. It is zero if the task has not run yet.
+	LastScheduled time.Time
+	// LastCompleted is the time the last execution completed. It is zero if the task has not completed yet.
+	LastCompleted time.Time
+}
+
+// Trigger determines the execution times of a task.
+type Trigger interface {
+	// Next returns the next execution time. It returns the zero time if the task should not run anymore.
+	Next(tc TriggerContext) time.Time
+}
+
+// CronTrigger is a Trigger that runs a task at the times matching a cron expression.
+type CronTrigger struct {
+	expr *CronExpression
+}
+
+// NewCronTrigger creates a new CronTrigger with the given cron expression.
+func NewCronTrigger(expr *CronExpression) *CronTrigger {
+	if expr == nil {
+		panic("nil cron expression")
+	}
+
+	return &CronTrigger{
+		expr: expr,
+	}
+}
+
+// Next returns the first time matching the cron expression after the current time.
+func (t *CronTrigger) Next(tc TriggerContext) time.Time {
+	after := tc.Now
+	if after.Before(tc.LastScheduled) {
+		after = tc.LastScheduled
+	}
+
+	return t.expr.Next(after)
+}
+
+// String returns the cron expression of the trigger.
+func (t *CronTrigger) String() string {
+	return fmt.Sprintf("cron '%s'", t.expr)
+}
+
+// FixedRateTrigger is a Trigger that runs a task periodically, measuring the period between
+// the scheduled times of successive executions. Executions missed while the previous one
+// was still running are skipped.
+type FixedRateTrigger struct {
+	period       time.Duration
+	initialDelay time.Duration
+}
+
+// NewFixedRateTrigger creates a new FixedRateTrigger with the given period and initial delay.
+func NewFixedRateTrigger(period time.Duration, initialDelay time.Duration) *FixedRateTrigger {
+	if period <= 0 {
+		panic("non-positive period")
+	}
+
+	if initialDelay < 0 {
+		panic("negative initial delay")
+	}
+
+	return &FixedRateTrigger{
+		period:       period,
+		initialDelay: initialDelay,
+	}
+}
+
+// Next returns the next execution time.
+func (t *FixedRateTrigger) Next(tc TriggerContext) time.Time {
+	if tc.LastScheduled.IsZero() {
+		return tc.Now.Add(t.initialDelay)
+	}
+
+	next := tc.LastScheduled.Add(t.period)
+	if next.Before(tc.Now) {
+		missed := (tc.Now.Sub(next) + t.period - 1) / t.period
+		next = next.Add(missed * t.period)
+	}
+
+	return next
+}
+
+// String returns the description of the trigger.
+func (t *FixedRateTrigger) String() string {
+	return fmt.Sprintf("fixed rate %s", t.period)
+}
+
+// FixedDelayTrigger is a Trigger that runs a task periodically, measuring the delay between
+// the completion of an execution and the start of the next one.
+type FixedDelayTrigger struct {
+	delay        time.Duration
+	initialDelay time.Duration
+}
+
+// NewFixedDelayTrigger creates a new FixedDelayTrigger with the given delay and initial delay.
+func NewFixedDelayTrigger(delay time.Duration, initialDelay time.Duration) *FixedDelayTrigger {
+	if delay <= 0 {
+		panic("non-positive delay")
+	}
+
+	if initialDelay < 0 {
+		panic("negative initial delay")
+	}
+
+	return &FixedDelayTrigger{
+		delay:        delay,
+		initialDelay: initialDelay,
+	}
+}
+
+// Next returns the next execution time.
+func (t *FixedDelayTrigger) Next(tc TriggerContext) time.Time {
+	if tc.LastCompleted.IsZero() {
+		return tc.Now.Add(t.initialDelay)
+	}
+
+	return tc.LastCompleted.Add(t.delay)
+}
+
+// String returns the description of the trigger.
+func (t *FixedDelayTrigger) String() string {
+	return fmt.Sprintf("fixed delay %s", t.delay)
+}
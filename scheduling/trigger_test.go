@@ -0,0 +1,129 @@
+// Copyright 2026 Codnect
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var anyStartTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestNewCronTrigger(t *testing.T) {
+	require.PanicsWithValue(t, "nil cron expression", func() {
+		NewCronTrigger(nil)
+	})
+}
+
+func TestCronTrigger_Next(t *testing.T) {
+	// given
+	trigger := NewCronTrigger(MustParseCron("*/30 * * * * *", time.UTC))
+
+	// when
+	first := trigger.Next(TriggerContext{Now: anyStartTime.Add(5 * time.Second)})
+	second := trigger.Next(TriggerContext{Now: anyStartTime.Add(29 * time.Second), LastScheduled: anyStartTime.Add(30 * time.Second)})
+
+	// then
+	assert.Equal(t, anyStartTime.Add(30*time.Second), first)
+	assert.Equal(t, anyStartTime.Add(time.Minute), second)
+	assert.Equal(t, "cron '*/30 * * * * *'", trigger.String())
+}
+
+func TestNewFixedRateTrigger(t *testing.T) {
+	require.PanicsWithValue(t, "non-positive period", func() {
+		NewFixedRateTrigger(0, 0)
+	})
+
+	require.PanicsWithValue(t, "negative initial delay", func() {
+		NewFixedRateTrigger(time.Second, -time.Second)
+	})
+}
+
+func TestFixedRateTrigger_Next(t *testing.T) {
+	testCases := []struct {
+		name string
+		tc   TriggerContext
+		want time.Time
+	}{
+		{
+			name: "first execution",
+			tc:   TriggerContext{Now: anyStartTime},
+			want: anyStartTime.Add(time.Second),
+		},
+		{
+			name: "next execution",
+			tc: TriggerContext{
+				Now:           anyStartTime.Add(3 * time.Second),
+				LastScheduled: anyStartTime.Add(time.Second),
+				LastCompleted: anyStartTime.Add(3 * time.Second),
+			},
+			want: anyStartTime.Add(6 * time.Second),
+		},
+		{
+			name: "missed executions",
+			tc: TriggerContext{
+				Now:           anyStartTime.Add(13 * time.Second),
+				LastScheduled: anyStartTime.Add(time.Second),
+				LastCompleted: anyStartTime.Add(13 * time.Second),
+			},
+			want: anyStartTime.Add(16 * time.Second),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			trigger := NewFixedRateTrigger(5*time.Second, time.Second)
+
+			// when
+			next := trigger.Next(tc.tc)
+
+			// then
+			assert.Equal(t, tc.want, next)
+			assert.Equal(t, "fixed rate 5s", trigger.String())
+		})
+	}
+}
+
+func TestNewFixedDelayTrigger(t *testing.T) {
+	require.PanicsWithValue(t, "non-positive delay", func() {
+		NewFixedDelayTrigger(-time.Second, 0)
+	})
+
+	require.PanicsWithValue(t, "negative initial delay", func() {
+		NewFixedDelayTrigger(time.Second, -time.Second)
+	})
+}
+
+func TestFixedDelayTrigger_Next(t *testing.T) {
+	// given
+	trigger := NewFixedDelayTrigger(5*time.Second, 2*time.Second)
+
+	// when
+	first := trigger.Next(TriggerContext{Now: anyStartTime})
+	second := trigger.Next(TriggerContext{
+		Now:           anyStartTime.Add(10 * time.Second),
+		LastScheduled: anyStartTime.Add(2 * time.Second),
+		LastCompleted: anyStartTime.Add(9 * time.Second),
+	})
+
+	// then
+	assert.Equal(t, anyStartTime.Add(2*time.Second), first)
+	assert.Equal(t, anyStartTime.Add(14*time.Second), second)
+	assert.Equal(t, "fixed delay 5s", trigger.String())
+}